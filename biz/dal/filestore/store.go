@@ -0,0 +1,65 @@
+package filestore
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.mokaz111.com/candy-agent/biz/utils"
+)
+
+// Store 基于本地文件的存储，每个对象保存为目录中的一个JSON文件，文件名由对象ID生成
+type Store[T any] struct {
+	dir string
+	id  func(v *T) string
+}
+
+// New 创建文件存储，id 返回对象的ID
+func New[T any](dir string, id func(v *T) string) (*Store[T], error) {
+	if dir == "" {
+		return nil, fmt.Errorf("store directory is required")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create store directory %s: %v", dir, err)
+	}
+	return &Store[T]{dir: dir, id: id}, nil
+}
+
+// Save 保存对象
+func (s *Store[T]) Save(v *T) error {
+	return utils.WriteJSONFile(s.path(s.id(v)), v)
+}
+
+// Delete 删除对象
+func (s *Store[T]) Delete(id string) error {
+	err := os.Remove(s.path(id))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// LoadAll 加载所有已保存的对象，损坏的文件会被跳过
+func (s *Store[T]) LoadAll() ([]*T, error) {
+	files, err := utils.ListJSONFiles(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list store directory %s: %v", s.dir, err)
+	}
+
+	values := make([]*T, 0, len(files))
+	for _, file := range files {
+		v := new(T)
+		if err := utils.ReadJSONFile(file, v); err != nil {
+			hlog.Errorf("Failed to load store file %s: %v", file, err)
+			continue
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+// path 对象文件路径
+func (s *Store[T]) path(id string) string {
+	return filepath.Join(s.dir, utils.EscapeFileName(id)+".json")
+}
@@ -0,0 +1,53 @@
+package filestore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type record struct {
+	ID    string `json:"id"`
+	Value int    `json:"value"`
+}
+
+func TestStore(t *testing.T) {
+	dir := t.TempDir()
+	store, err := New(dir, func(r *record) string { return r.ID })
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	for _, r := range []*record{{ID: "a", Value: 1}, {ID: "b/c", Value: 2}, {ID: "a", Value: 3}} {
+		if err := store.Save(r); err != nil {
+			t.Fatalf("Save(%s): %v", r.ID, err)
+		}
+	}
+	// 损坏的文件被跳过
+	if err := os.WriteFile(filepath.Join(dir, "broken.json"), []byte("{"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := store.LoadAll()
+	if err != nil {
+		t.Fatalf("LoadAll: %v", err)
+	}
+	got := make(map[string]int)
+	for _, r := range loaded {
+		got[r.ID] = r.Value
+	}
+	if len(got) != 2 || got["a"] != 3 || got["b/c"] != 2 {
+		t.Fatalf("LoadAll = %v, want a=3 b/c=2", got)
+	}
+
+	if err := store.Delete("b/c"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if err := store.Delete("missing"); err != nil {
+		t.Fatalf("Delete missing: %v", err)
+	}
+	loaded, _ = store.LoadAll()
+	if len(loaded) != 1 || loaded[0].ID != "a" {
+		t.Fatalf("LoadAll after delete = %v", loaded)
+	}
+}
@@ -0,0 +1,36 @@
+package executor
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+)
+
+// applyCommandResult 根据命令的退出状态和输出设置巡检结果，SSH 和 shell 执行器共用。
+// 命令失败时结果值为标准错误输出，成功时为标准输出，输出包含 threshold 时为警告
+func applyCommandResult(result *model.TaskResult, item model.TaskItem, stdout, stderr string, exitCode int, runErr error) {
+	if result.Labels == nil {
+		result.Labels = make(map[string]string)
+	}
+	result.Labels["exit_code"] = strconv.Itoa(exitCode)
+
+	if runErr != nil {
+		result.Status = model.ResultStatusFailed
+		result.Message = fmt.Sprintf("Command execution failed: %v", runErr)
+		result.Value = stderr
+		return
+	}
+
+	result.Value = stdout
+	result.Message = "Command executed successfully"
+
+	// 检查阈值
+	if threshold, ok := item.Params["threshold"].(string); ok && threshold != "" {
+		if strings.Contains(result.Value, threshold) {
+			result.Status = model.ResultStatusWarning
+			result.Message = fmt.Sprintf("Output contains threshold string: %s", threshold)
+		}
+	}
+}
@@ -0,0 +1,273 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+	"github.mokaz111.com/candy-agent/conf"
+)
+
+// DNSExecutor DNS 解析检查执行器
+type DNSExecutor struct {
+	config conf.DNSConfig
+}
+
+// NewDNSExecutor 创建 DNS 执行器
+func NewDNSExecutor(config conf.DNSConfig) *DNSExecutor {
+	return &DNSExecutor{config: config}
+}
+
+// Name 执行器名称
+func (e *DNSExecutor) Name() string {
+	return "dns"
+}
+
+// Schema 执行器参数说明
+func (e *DNSExecutor) Schema() Schema {
+	return Schema{
+		Name:        e.Name(),
+		Description: "解析域名，检查解析结果是否符合预期",
+		Params: []ParamSchema{
+			{Name: "name", Type: ParamTypeString, Required: true, Description: "要解析的域名"},
+			{Name: "record_type", Type: ParamTypeString, Default: "A", Enum: []string{"A", "AAAA", "CNAME", "MX", "NS", "TXT", "SRV"}, Description: "记录类型"},
+			{Name: "expected", Type: ParamTypeString, Description: "期望的解析结果，逗号分隔；MX、NS 为主机名，SRV 为 target:port"},
+			{Name: "match", Type: ParamTypeString, Default: "contains", Enum: []string{"contains", "exact", "any"}, Description: "期望结果的匹配方式：contains 包含全部期望结果，exact 与期望结果完全一致，any 包含任一期望结果"},
+			{Name: "resolver", Type: ParamTypeString, Description: "使用的 DNS 服务器 host:port，不设置时使用执行器配置或系统配置"},
+			{Name: "latency_warning", Type: ParamTypeInteger, Description: "解析延迟超过该值(毫秒)时为警告"},
+			{Name: "latency_critical", Type: ParamTypeInteger, Description: "解析延迟超过该值(毫秒)时为严重"},
+		},
+	}
+}
+
+// Target 返回使用的 DNS 服务器，使用系统配置时为空
+func (e *DNSExecutor) Target(item model.TaskItem) string {
+	return e.resolverAddress(item)
+}
+
+// resolverAddress 任务项使用的 DNS 服务器地址，未指定端口时使用53
+func (e *DNSExecutor) resolverAddress(item model.TaskItem) string {
+	address := paramString(item, "resolver")
+	if address == "" {
+		address = e.config.Resolver
+	}
+	if address == "" {
+		return ""
+	}
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(address, "53")
+	}
+	return address
+}
+
+// newResolver 创建解析器，指定了 DNS 服务器时所有查询都发往该服务器
+func (e *DNSExecutor) newResolver(item model.TaskItem) *net.Resolver {
+	address := e.resolverAddress(item)
+	if address == "" {
+		return net.DefaultResolver
+	}
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, network, address)
+		},
+	}
+}
+
+// Execute 执行巡检项
+func (e *DNSExecutor) Execute(ctx context.Context, item model.TaskItem) (model.TaskResult, error) {
+	startTime := time.Now()
+	result := model.TaskResult{
+		ItemID: item.ID,
+		Status: model.ResultStatusNormal,
+	}
+
+	name := paramString(item, "name")
+	recordType := strings.ToUpper(paramString(item, "record_type"))
+	if recordType == "" {
+		recordType = "A"
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, itemTimeout(item, e.config.Timeout, 10))
+	defer cancel()
+
+	answers, err := lookupRecords(ctx, e.newResolver(item), name, recordType)
+	latency := time.Since(startTime).Milliseconds()
+	result.Labels = map[string]string{
+		"record_type": recordType,
+		"latency_ms":  strconv.FormatInt(latency, 10),
+	}
+	if resolver := e.resolverAddress(item); resolver != "" {
+		result.Labels["resolver"] = resolver
+	}
+
+	var dnsErr *net.DNSError
+	switch {
+	case err != nil && errors.As(err, &dnsErr) && dnsErr.IsNotFound:
+		// 域名或记录不存在是巡检发现的问题，不是执行错误
+		result.Status = model.ResultStatusCritical
+		result.Message = fmt.Sprintf("%s 没有 %s 记录", name, recordType)
+	case err != nil:
+		result.Status = model.ResultStatusFailed
+		result.Message = fmt.Sprintf("解析 %s 失败: %v", name, err)
+		result.Duration = time.Since(startTime).Milliseconds()
+		return result, err
+	}
+	result.Value = strings.Join(answers, ",")
+
+	if result.Status == model.ResultStatusNormal {
+		var critical, warnings []string
+		if expected := splitExpected(paramString(item, "expected")); len(expected) > 0 {
+			if msg := matchAnswers(answers, expected, paramString(item, "match")); msg != "" {
+				critical = append(critical, msg)
+			}
+		}
+		if limit, ok := paramFloat(item, "latency_critical"); ok && limit > 0 && float64(latency) > limit {
+			critical = append(critical, fmt.Sprintf("解析延迟 %dms 超过 %.0fms", latency, limit))
+		} else if limit, ok := paramFloat(item, "latency_warning"); ok && limit > 0 && float64(latency) > limit {
+			warnings = append(warnings, fmt.Sprintf("解析延迟 %dms 超过 %.0fms", latency, limit))
+		}
+
+		switch {
+		case len(critical) > 0:
+			result.Status = model.ResultStatusCritical
+			result.Message = strings.Join(append(critical, warnings...), "; ")
+		case len(warnings) > 0:
+			result.Status = model.ResultStatusWarning
+			result.Message = strings.Join(warnings, "; ")
+		default:
+			result.Message = fmt.Sprintf("%s 解析到 %d 条 %s 记录，延迟 %dms", name, len(answers), recordType, latency)
+		}
+	}
+
+	var details strings.Builder
+	details.WriteString(fmt.Sprintf("Name: %s\n", name))
+	details.WriteString(fmt.Sprintf("Record Type: %s\n", recordType))
+	if resolver := e.resolverAddress(item); resolver != "" {
+		details.WriteString(fmt.Sprintf("Resolver: %s\n", resolver))
+	}
+	details.WriteString(fmt.Sprintf("Answers: %s\n", strings.Join(answers, ", ")))
+	details.WriteString(fmt.Sprintf("Latency: %dms\n", latency))
+	result.Details = details.String()
+	result.Duration = time.Since(startTime).Milliseconds()
+
+	return result, nil
+}
+
+// lookupRecords 查询指定类型的记录，返回排序后的结果，主机名去掉末尾的点
+func lookupRecords(ctx context.Context, resolver *net.Resolver, name, recordType string) ([]string, error) {
+	var answers []string
+	switch recordType {
+	case "A", "AAAA":
+		network := "ip4"
+		if recordType == "AAAA" {
+			network = "ip6"
+		}
+		ips, err := resolver.LookupIP(ctx, network, name)
+		if err != nil {
+			return nil, err
+		}
+		for _, ip := range ips {
+			answers = append(answers, ip.String())
+		}
+	case "CNAME":
+		cname, err := resolver.LookupCNAME(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		answers = append(answers, normalizeHost(cname))
+	case "MX":
+		records, err := resolver.LookupMX(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range records {
+			answers = append(answers, normalizeHost(record.Host))
+		}
+	case "NS":
+		records, err := resolver.LookupNS(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range records {
+			answers = append(answers, normalizeHost(record.Host))
+		}
+	case "TXT":
+		records, err := resolver.LookupTXT(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		answers = append(answers, records...)
+	case "SRV":
+		_, records, err := resolver.LookupSRV(ctx, "", "", name)
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range records {
+			answers = append(answers, net.JoinHostPort(normalizeHost(record.Target), strconv.Itoa(int(record.Port))))
+		}
+	default:
+		return nil, fmt.Errorf("不支持的记录类型: %s", recordType)
+	}
+	sort.Strings(answers)
+	return answers, nil
+}
+
+// normalizeHost 主机名转为小写并去掉末尾的点
+func normalizeHost(host string) string {
+	return strings.ToLower(strings.TrimSuffix(host, "."))
+}
+
+// splitExpected 解析逗号分隔的期望结果
+func splitExpected(s string) []string {
+	var expected []string
+	for _, value := range strings.Split(s, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			expected = append(expected, value)
+		}
+	}
+	return expected
+}
+
+// matchAnswers 按匹配方式比较解析结果和期望结果，返回不符合预期的原因。
+// 解析结果和期望结果都按集合比较，重复的值只计一次
+func matchAnswers(answers, expected []string, match string) string {
+	actual := make(map[string]bool, len(answers))
+	for _, answer := range answers {
+		actual[normalizeHost(answer)] = true
+	}
+	wanted := make(map[string]bool, len(expected))
+	for _, value := range expected {
+		wanted[normalizeHost(value)] = true
+	}
+
+	var missing []string
+	for _, value := range expected {
+		if !actual[normalizeHost(value)] {
+			missing = append(missing, value)
+		}
+	}
+
+	switch match {
+	case "any":
+		if len(missing) == len(expected) {
+			return fmt.Sprintf("解析结果 %s 不包含任一期望结果 %s", strings.Join(answers, ","), strings.Join(expected, ","))
+		}
+	case "exact":
+		if len(missing) > 0 || len(actual) != len(wanted) {
+			return fmt.Sprintf("解析结果 %s 与期望结果 %s 不一致", strings.Join(answers, ","), strings.Join(expected, ","))
+		}
+	default:
+		if len(missing) > 0 {
+			return fmt.Sprintf("解析结果 %s 缺少 %s", strings.Join(answers, ","), strings.Join(missing, ","))
+		}
+	}
+	return ""
+}
@@ -0,0 +1,213 @@
+package executor
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+	"github.mokaz111.com/candy-agent/conf"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// stubRecords 桩 DNS 服务器的 A 记录，silent.test. 不应答，其他域名返回 NXDOMAIN
+var stubRecords = map[string][]string{
+	"svc.test.": {"10.0.0.2", "10.0.0.1"},
+	"dup.test.": {"10.0.0.1", "10.0.0.1"},
+}
+
+// startStubResolver 在本地 UDP 端口启动桩 DNS 服务器，返回服务器地址
+func startStubResolver(t *testing.T) string {
+	t.Helper()
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("ListenPacket: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	go func() {
+		buf := make([]byte, 1500)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			if reply := stubReply(buf[:n]); reply != nil {
+				conn.WriteTo(reply, addr)
+			}
+		}
+	}()
+	return conn.LocalAddr().String()
+}
+
+// stubReply 按 stubRecords 构造应答，不应答时返回 nil
+func stubReply(query []byte) []byte {
+	var parser dnsmessage.Parser
+	header, err := parser.Start(query)
+	if err != nil {
+		return nil
+	}
+	question, err := parser.Question()
+	if err != nil {
+		return nil
+	}
+	name := question.Name.String()
+	if name == "silent.test." {
+		return nil
+	}
+
+	records, found := stubRecords[name]
+	header.Response = true
+	header.Authoritative = true
+	if !found {
+		header.RCode = dnsmessage.RCodeNameError
+	}
+	builder := dnsmessage.NewBuilder(nil, header)
+	builder.EnableCompression()
+	builder.StartQuestions()
+	builder.Question(question)
+	builder.StartAnswers()
+	if question.Type == dnsmessage.TypeA {
+		for _, record := range records {
+			var a dnsmessage.AResource
+			copy(a.A[:], net.ParseIP(record).To4())
+			builder.AResource(dnsmessage.ResourceHeader{Name: question.Name, Class: dnsmessage.ClassINET, TTL: 60}, a)
+		}
+	}
+	reply, err := builder.Finish()
+	if err != nil {
+		return nil
+	}
+	return reply
+}
+
+func TestDNSExecute(t *testing.T) {
+	resolver := startStubResolver(t)
+	tests := []struct {
+		name        string
+		params      map[string]interface{}
+		wantStatus  model.ResultStatus
+		wantValue   string
+		wantMessage string
+	}{
+		{
+			name:       "resolves sorted answers",
+			params:     map[string]interface{}{"name": "svc.test."},
+			wantStatus: model.ResultStatusNormal, wantValue: "10.0.0.1,10.0.0.2", wantMessage: "解析到 2 条 A 记录",
+		},
+		{
+			name:       "contains",
+			params:     map[string]interface{}{"name": "svc.test.", "expected": "10.0.0.1", "match": "contains"},
+			wantStatus: model.ResultStatusNormal,
+		},
+		{
+			name:       "contains missing",
+			params:     map[string]interface{}{"name": "svc.test.", "expected": "10.0.0.1,10.0.0.3"},
+			wantStatus: model.ResultStatusCritical, wantMessage: "缺少 10.0.0.3",
+		},
+		{
+			name:       "exact",
+			params:     map[string]interface{}{"name": "svc.test.", "expected": "10.0.0.2, 10.0.0.1", "match": "exact"},
+			wantStatus: model.ResultStatusNormal,
+		},
+		{
+			name:       "exact with extra answer",
+			params:     map[string]interface{}{"name": "svc.test.", "expected": "10.0.0.1", "match": "exact"},
+			wantStatus: model.ResultStatusCritical, wantMessage: "不一致",
+		},
+		{
+			name:       "exact with duplicate expected",
+			params:     map[string]interface{}{"name": "svc.test.", "expected": "10.0.0.1,10.0.0.2,10.0.0.1", "match": "exact"},
+			wantStatus: model.ResultStatusNormal,
+		},
+		{
+			name:       "exact with duplicate answers",
+			params:     map[string]interface{}{"name": "dup.test.", "expected": "10.0.0.1", "match": "exact"},
+			wantStatus: model.ResultStatusNormal,
+		},
+		{
+			name:       "any",
+			params:     map[string]interface{}{"name": "svc.test.", "expected": "10.0.0.3,10.0.0.2", "match": "any"},
+			wantStatus: model.ResultStatusNormal,
+		},
+		{
+			name:       "any none matched",
+			params:     map[string]interface{}{"name": "svc.test.", "expected": "10.0.0.3,10.0.0.4", "match": "any"},
+			wantStatus: model.ResultStatusCritical, wantMessage: "不包含任一期望结果",
+		},
+		{
+			name:       "not found",
+			params:     map[string]interface{}{"name": "missing.test."},
+			wantStatus: model.ResultStatusCritical, wantMessage: "没有 A 记录",
+		},
+	}
+	e := NewDNSExecutor(conf.DNSConfig{})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.params["resolver"] = resolver
+			result, err := e.Execute(context.Background(), model.TaskItem{ID: 1, Type: "dns", TimeoutSeconds: 2, Params: tt.params})
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if result.Status != tt.wantStatus || !strings.Contains(result.Message, tt.wantMessage) {
+				t.Errorf("result = %s %q, want %s %q", result.Status, result.Message, tt.wantStatus, tt.wantMessage)
+			}
+			if tt.wantValue != "" && result.Value != tt.wantValue {
+				t.Errorf("value = %q, want %q", result.Value, tt.wantValue)
+			}
+			if result.Labels["resolver"] != resolver {
+				t.Errorf("resolver label = %q, want %q", result.Labels["resolver"], resolver)
+			}
+		})
+	}
+}
+
+func TestDNSExecuteCanceled(t *testing.T) {
+	resolver := startStubResolver(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	start := time.Now()
+	result, err := NewDNSExecutor(conf.DNSConfig{}).Execute(ctx, model.TaskItem{ID: 1, Type: "dns", TimeoutSeconds: 30,
+		Params: map[string]interface{}{"name": "silent.test.", "resolver": resolver}})
+	if err == nil {
+		t.Fatal("Execute() error = nil, want error after cancel")
+	}
+	if result.Status != model.ResultStatusFailed {
+		t.Errorf("status = %s, want failed", result.Status)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Execute() returned after %v, want prompt return on cancel", elapsed)
+	}
+}
+
+func TestMatchAnswers(t *testing.T) {
+	tests := []struct {
+		name     string
+		answers  []string
+		expected []string
+		match    string
+		wantErr  string
+	}{
+		{name: "contains host ignores case and dot", answers: []string{"mx1.example.com"}, expected: []string{"MX1.Example.com."}, match: "contains"},
+		{name: "default is contains", answers: []string{"a", "b"}, expected: []string{"c"}, wantErr: "缺少 c"},
+		{name: "exact", answers: []string{"a", "b"}, expected: []string{"b", "a"}, match: "exact"},
+		{name: "exact missing", answers: []string{"a"}, expected: []string{"a", "b"}, match: "exact", wantErr: "不一致"},
+		{name: "exact extra", answers: []string{"a", "b"}, expected: []string{"a"}, match: "exact", wantErr: "不一致"},
+		{name: "exact duplicate expected", answers: []string{"a", "b"}, expected: []string{"a", "b", "A."}, match: "exact"},
+		{name: "exact duplicate expected missing one", answers: []string{"a", "b"}, expected: []string{"a", "a"}, match: "exact", wantErr: "不一致"},
+		{name: "any", answers: []string{"a"}, expected: []string{"b", "a"}, match: "any"},
+		{name: "any none", answers: []string{"a"}, expected: []string{"b", "c"}, match: "any", wantErr: "不包含任一期望结果"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := matchAnswers(tt.answers, tt.expected, tt.match)
+			if (tt.wantErr == "") != (msg == "") || !strings.Contains(msg, tt.wantErr) {
+				t.Fatalf("matchAnswers() = %q, want %q", msg, tt.wantErr)
+			}
+		})
+	}
+}
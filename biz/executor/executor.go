@@ -2,6 +2,8 @@ package executor
 
 import (
 	"context"
+	"time"
+
 	"github.mokaz111.com/candy-agent/biz/model"
 )
 
@@ -11,6 +13,8 @@ type Executor interface {
 	Execute(ctx context.Context, item model.TaskItem) (model.TaskResult, error)
 	// Name 执行器名称
 	Name() string
+	// Schema 执行器参数说明，提交任务时按此校验任务项参数
+	Schema() Schema
 }
 
 // Factory 执行器工厂
@@ -18,3 +22,15 @@ type Factory interface {
 	// Create 创建执行器
 	Create(executorType string) (Executor, error)
 }
+
+// itemTimeout 获取任务项的执行超时，任务项设置了 timeout_seconds 时优先使用，否则使用执行器配置，都未设置时使用默认值(秒)
+func itemTimeout(item model.TaskItem, configTimeout, defaultTimeout int) time.Duration {
+	timeout := configTimeout
+	if item.TimeoutSeconds > 0 {
+		timeout = item.TimeoutSeconds
+	}
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	return time.Duration(timeout) * time.Second
+}
@@ -3,6 +3,7 @@ package executor
 import (
 	"fmt"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"sort"
 	"sync"
 
 	"github.mokaz111.com/candy-agent/conf"
@@ -42,6 +43,21 @@ func (f *ExecutorFactory) Create(executorType string) (Executor, error) {
 	return executor, nil
 }
 
+// Schemas 获取所有已注册执行器的参数说明，按名称排序
+func (f *ExecutorFactory) Schemas() []Schema {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	schemas := make([]Schema, 0, len(f.executors))
+	for _, executor := range f.executors {
+		schemas = append(schemas, executor.Schema())
+	}
+	sort.Slice(schemas, func(i, j int) bool {
+		return schemas[i].Name < schemas[j].Name
+	})
+	return schemas
+}
+
 var (
 	factory *ExecutorFactory
 	once    sync.Once
@@ -84,9 +100,31 @@ func InitExecutors(cfg *conf.Config) error {
 		f.Register(vmExecutor)
 	}
 
+	// 注册 Kubernetes 执行器，在集群外运行时无法创建集群内客户端，只跳过该执行器
+	if k8sConfig := cfg.Executors.Kubernetes; k8sConfig.InCluster || k8sConfig.KubeConfig != "" {
+		kubernetesExecutor, err := NewKubernetesExecutor(k8sConfig)
+		if err != nil {
+			hlog.Warnf("Skip kubernetes executor: %v", err)
+		} else {
+			f.Register(kubernetesExecutor)
+		}
+	}
+
 	// 注册 SSH 执行器
 	sshExecutor := NewSSHExecutor(cfg.Executors.SSH)
 	f.Register(sshExecutor)
 
+	// 注册 HTTP 执行器
+	f.Register(NewHTTPExecutor(cfg.Executors.HTTP))
+
+	// 注册 TCP 和 DNS 执行器
+	f.Register(NewTCPExecutor(cfg.Executors.TCP))
+	f.Register(NewDNSExecutor(cfg.Executors.DNS))
+
+	// 注册 shell 执行器，在 Agent 容器内执行命令，需要显式开启
+	if cfg.Executors.Shell.Enabled {
+		f.Register(NewShellExecutor(cfg.Executors.Shell))
+	}
+
 	return nil
 }
@@ -0,0 +1,351 @@
+package executor
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+	"github.mokaz111.com/candy-agent/conf"
+)
+
+// maxHTTPBodySize 读取响应体的最大字节数，断言只针对这部分内容
+const maxHTTPBodySize = 1 << 20
+
+// HTTPExecutor HTTP 接口探测执行器
+type HTTPExecutor struct {
+	config conf.HTTPConfig
+}
+
+// NewHTTPExecutor 创建 HTTP 执行器
+func NewHTTPExecutor(config conf.HTTPConfig) *HTTPExecutor {
+	return &HTTPExecutor{config: config}
+}
+
+// Name 执行器名称
+func (e *HTTPExecutor) Name() string {
+	return "http"
+}
+
+// Schema 执行器参数说明
+func (e *HTTPExecutor) Schema() Schema {
+	return Schema{
+		Name:        e.Name(),
+		Description: "请求 HTTP 接口，检查状态码、响应内容和延迟，HTTPS 接口同时检查证书有效期",
+		Params: []ParamSchema{
+			{Name: "url", Type: ParamTypeString, Required: true, Description: "请求地址"},
+			{Name: "method", Type: ParamTypeString, Default: http.MethodGet, Enum: []string{http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodOptions}, Description: "请求方法"},
+			{Name: "headers", Type: ParamTypeString, Secret: true, Description: "请求头，JSON 对象，如 {\"Authorization\": \"Bearer xxx\"}"},
+			{Name: "body", Type: ParamTypeString, Description: "请求体"},
+			{Name: "expected_status", Type: ParamTypeString, Default: "200-299", Description: "期望的状态码，逗号分隔的状态码或范围，如 200,301-302"},
+			{Name: "body_regex", Type: ParamTypeString, Description: "响应体需要匹配的正则表达式"},
+			{Name: "json_path", Type: ParamTypeString, Description: "响应体 JSON 中需要存在的字段路径，点分隔，数组使用下标，如 data.items.0.status"},
+			{Name: "json_value", Type: ParamTypeString, Description: "json_path 字段的期望值"},
+			{Name: "latency_warning", Type: ParamTypeInteger, Description: "延迟超过该值(毫秒)时为警告"},
+			{Name: "latency_critical", Type: ParamTypeInteger, Description: "延迟超过该值(毫秒)时为严重"},
+			{Name: "cert_warning_days", Type: ParamTypeInteger, Default: "30", Description: "证书剩余有效天数少于该值时为警告"},
+			{Name: "cert_critical_days", Type: ParamTypeInteger, Default: "7", Description: "证书剩余有效天数少于该值时为严重"},
+			{Name: "follow_redirects", Type: ParamTypeBoolean, Default: "true", Description: "是否跟随重定向"},
+			{Name: "insecure_skip_verify", Type: ParamTypeBoolean, Default: "false", Description: "是否跳过证书校验"},
+		},
+	}
+}
+
+// Target 返回请求的主机地址
+func (e *HTTPExecutor) Target(item model.TaskItem) string {
+	u, err := url.Parse(paramString(item, "url"))
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
+
+// Probe 检查请求地址的端口是否可以建立 TCP 连接，不发送请求
+func (e *HTTPExecutor) Probe(ctx context.Context, item model.TaskItem) error {
+	u, err := url.Parse(paramString(item, "url"))
+	if err != nil {
+		return fmt.Errorf("请求地址无效: %v", err)
+	}
+	port := u.Port()
+	if port == "" {
+		port = "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+	}
+	address := net.JoinHostPort(u.Hostname(), port)
+
+	dialer := net.Dialer{Timeout: itemTimeout(item, e.config.Timeout, 10)}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return fmt.Errorf("连接 %s 失败: %v", address, err)
+	}
+	return conn.Close()
+}
+
+// Execute 执行巡检项
+func (e *HTTPExecutor) Execute(ctx context.Context, item model.TaskItem) (model.TaskResult, error) {
+	startTime := time.Now()
+	result := model.TaskResult{
+		ItemID: item.ID,
+		Status: model.ResultStatusNormal,
+	}
+	fail := func(err error) (model.TaskResult, error) {
+		result.Status = model.ResultStatusFailed
+		result.Message = err.Error()
+		result.Duration = time.Since(startTime).Milliseconds()
+		return result, err
+	}
+
+	req, err := e.buildRequest(ctx, item)
+	if err != nil {
+		return fail(err)
+	}
+	expected, err := parseStatusRanges(paramString(item, "expected_status"))
+	if err != nil {
+		return fail(err)
+	}
+
+	client := &http.Client{
+		Timeout: itemTimeout(item, e.config.Timeout, 10),
+		Transport: &http.Transport{
+			Proxy:             http.ProxyFromEnvironment,
+			TLSClientConfig:   &tls.Config{InsecureSkipVerify: paramBool(item, "insecure_skip_verify", false)},
+			DisableKeepAlives: true,
+		},
+	}
+	if !paramBool(item, "follow_redirects", true) {
+		client.CheckRedirect = func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }
+	}
+
+	requestStart := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		return fail(fmt.Errorf("请求失败: %v", err))
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxHTTPBodySize))
+	latency := time.Since(requestStart).Milliseconds()
+	if err != nil {
+		return fail(fmt.Errorf("读取响应失败: %v", err))
+	}
+
+	result.Value = strconv.FormatInt(latency, 10)
+	result.Labels = map[string]string{
+		"url":         req.URL.String(),
+		"status_code": strconv.Itoa(resp.StatusCode),
+	}
+
+	// 检查断言，严重问题优先
+	var critical, warnings []string
+	if !statusInRanges(resp.StatusCode, expected) {
+		critical = append(critical, fmt.Sprintf("状态码 %d 不在期望范围 %s 内", resp.StatusCode, paramString(item, "expected_status")))
+	}
+	if pattern := paramString(item, "body_regex"); pattern != "" {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return fail(fmt.Errorf("body_regex 无效: %v", err))
+		}
+		if !re.Match(body) {
+			critical = append(critical, fmt.Sprintf("响应体不匹配 %s", pattern))
+		}
+	}
+	if path := paramString(item, "json_path"); path != "" {
+		if msg := checkJSONPath(body, path, item.Params["json_value"]); msg != "" {
+			critical = append(critical, msg)
+		}
+	}
+	if limit, ok := paramFloat(item, "latency_critical"); ok && limit > 0 && float64(latency) > limit {
+		critical = append(critical, fmt.Sprintf("延迟 %dms 超过 %.0fms", latency, limit))
+	} else if limit, ok := paramFloat(item, "latency_warning"); ok && limit > 0 && float64(latency) > limit {
+		warnings = append(warnings, fmt.Sprintf("延迟 %dms 超过 %.0fms", latency, limit))
+	}
+
+	var certInfo string
+	if resp.TLS != nil && len(resp.TLS.PeerCertificates) > 0 {
+		cert := resp.TLS.PeerCertificates[0]
+		daysLeft := int(time.Until(cert.NotAfter).Hours() / 24)
+		result.Labels["cert_days_left"] = strconv.Itoa(daysLeft)
+		certInfo = fmt.Sprintf("Certificate: %s, expires %s (%d days left)\n", cert.Subject.CommonName, cert.NotAfter.Format(time.RFC3339), daysLeft)
+
+		criticalDays, ok := paramFloat(item, "cert_critical_days")
+		if !ok {
+			criticalDays = 7
+		}
+		warningDays, ok := paramFloat(item, "cert_warning_days")
+		if !ok {
+			warningDays = 30
+		}
+		if float64(daysLeft) < criticalDays {
+			critical = append(critical, fmt.Sprintf("证书 %d 天后过期", daysLeft))
+		} else if float64(daysLeft) < warningDays {
+			warnings = append(warnings, fmt.Sprintf("证书 %d 天后过期", daysLeft))
+		}
+	}
+
+	switch {
+	case len(critical) > 0:
+		result.Status = model.ResultStatusCritical
+		result.Message = strings.Join(append(critical, warnings...), "; ")
+	case len(warnings) > 0:
+		result.Status = model.ResultStatusWarning
+		result.Message = strings.Join(warnings, "; ")
+	default:
+		result.Message = fmt.Sprintf("状态码 %d，延迟 %dms", resp.StatusCode, latency)
+	}
+
+	var details strings.Builder
+	details.WriteString(fmt.Sprintf("Request: %s %s\n", req.Method, req.URL.String()))
+	details.WriteString(fmt.Sprintf("Status: %s\n", resp.Status))
+	details.WriteString(fmt.Sprintf("Latency: %dms\n", latency))
+	details.WriteString(certInfo)
+	details.WriteString(fmt.Sprintf("Body: %s\n", truncateString(string(body), 1024)))
+	result.Details = details.String()
+	result.Duration = time.Since(startTime).Milliseconds()
+
+	return result, nil
+}
+
+// buildRequest 根据任务项参数构建请求
+func (e *HTTPExecutor) buildRequest(ctx context.Context, item model.TaskItem) (*http.Request, error) {
+	rawURL := paramString(item, "url")
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("请求地址无效: %q", rawURL)
+	}
+
+	method := strings.ToUpper(paramString(item, "method"))
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	var body io.Reader
+	if b := paramString(item, "body"); b != "" {
+		body = strings.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("创建请求失败: %v", err)
+	}
+
+	if headers := paramString(item, "headers"); headers != "" {
+		values := make(map[string]string)
+		if err := json.Unmarshal([]byte(headers), &values); err != nil {
+			return nil, fmt.Errorf("headers 应为 JSON 对象: %v", err)
+		}
+		for key, value := range values {
+			req.Header.Set(key, value)
+		}
+		if host, exists := values["Host"]; exists {
+			req.Host = host
+		}
+	}
+	return req, nil
+}
+
+// statusRange 状态码范围，包含两端
+type statusRange struct {
+	min, max int
+}
+
+// parseStatusRanges 解析期望状态码，格式为逗号分隔的状态码或范围，如 200,301-302
+func parseStatusRanges(s string) ([]statusRange, error) {
+	if strings.TrimSpace(s) == "" {
+		s = "200-299"
+	}
+
+	var ranges []statusRange
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		bounds := strings.SplitN(part, "-", 2)
+		min, err := strconv.Atoi(strings.TrimSpace(bounds[0]))
+		if err != nil {
+			return nil, fmt.Errorf("expected_status 无效: %q", part)
+		}
+		max := min
+		if len(bounds) == 2 {
+			if max, err = strconv.Atoi(strings.TrimSpace(bounds[1])); err != nil || max < min {
+				return nil, fmt.Errorf("expected_status 无效: %q", part)
+			}
+		}
+		ranges = append(ranges, statusRange{min: min, max: max})
+	}
+	return ranges, nil
+}
+
+// statusInRanges 状态码是否在期望范围内
+func statusInRanges(code int, ranges []statusRange) bool {
+	for _, r := range ranges {
+		if code >= r.min && code <= r.max {
+			return true
+		}
+	}
+	return false
+}
+
+// checkJSONPath 检查响应体 JSON 中的字段，expected 不为空时比较字段值，返回断言失败的原因
+func checkJSONPath(body []byte, path string, expected interface{}) string {
+	var current interface{}
+	if err := json.Unmarshal(body, &current); err != nil {
+		return fmt.Sprintf("响应体不是有效的 JSON: %v", err)
+	}
+
+	for _, key := range strings.Split(strings.TrimPrefix(path, "$."), ".") {
+		switch node := current.(type) {
+		case map[string]interface{}:
+			next, exists := node[key]
+			if !exists {
+				return fmt.Sprintf("响应 JSON 中不存在字段 %s", path)
+			}
+			current = next
+		case []interface{}:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(node) {
+				return fmt.Sprintf("响应 JSON 中不存在字段 %s", path)
+			}
+			current = node[i]
+		default:
+			return fmt.Sprintf("响应 JSON 中不存在字段 %s", path)
+		}
+	}
+
+	want, ok := expected.(string)
+	if !ok || want == "" {
+		return ""
+	}
+	var actual string
+	switch v := current.(type) {
+	case string:
+		actual = v
+	case float64:
+		actual = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		data, _ := json.Marshal(v)
+		actual = string(data)
+	}
+	if actual != want {
+		return fmt.Sprintf("字段 %s 的值为 %s，期望 %s", path, actual, want)
+	}
+	return ""
+}
+
+// truncateString 截断超过 max 字节的字符串，在字符边界截断，避免产生无效的 UTF-8
+func truncateString(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	for max > 0 && !utf8.RuneStart(s[max]) {
+		max--
+	}
+	return s[:max] + "..."
+}
@@ -0,0 +1,103 @@
+package executor
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+	"github.mokaz111.com/candy-agent/conf"
+)
+
+func TestTruncateString(t *testing.T) {
+	tests := []struct {
+		s    string
+		max  int
+		want string
+	}{
+		{s: "hello", max: 10, want: "hello"},
+		{s: "hello", max: 5, want: "hello"},
+		{s: "hello world", max: 5, want: "hello..."},
+		{s: "巡检结果", max: 6, want: "巡检..."},
+		{s: "巡检结果", max: 7, want: "巡检..."},
+		{s: "巡检结果", max: 2, want: "..."},
+		{s: "a巡检", max: 3, want: "a..."},
+	}
+	for _, tt := range tests {
+		got := truncateString(tt.s, tt.max)
+		if got != tt.want {
+			t.Errorf("truncateString(%q, %d) = %q, want %q", tt.s, tt.max, got, tt.want)
+		}
+		if !utf8.ValidString(got) {
+			t.Errorf("truncateString(%q, %d) = %q is not valid UTF-8", tt.s, tt.max, got)
+		}
+	}
+}
+
+func TestHTTPCertDefaultThresholds(t *testing.T) {
+	tests := []struct {
+		name       string
+		validFor   time.Duration
+		params     map[string]interface{}
+		wantStatus model.ResultStatus
+	}{
+		{name: "default critical", validFor: 3 * 24 * time.Hour, wantStatus: model.ResultStatusCritical},
+		{name: "default warning", validFor: 20 * 24 * time.Hour, wantStatus: model.ResultStatusWarning},
+		{name: "default normal", validFor: 90 * 24 * time.Hour, wantStatus: model.ResultStatusNormal},
+		{name: "configured warning", validFor: 90 * 24 * time.Hour, params: map[string]interface{}{"cert_warning_days": "120"}, wantStatus: model.ResultStatusWarning},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+			server.TLS = &tls.Config{Certificates: []tls.Certificate{testCertificate(t, tt.validFor)}}
+			server.StartTLS()
+			defer server.Close()
+
+			params := map[string]interface{}{"url": server.URL, "insecure_skip_verify": "true"}
+			for name, value := range tt.params {
+				params[name] = value
+			}
+			result, err := NewHTTPExecutor(conf.HTTPConfig{}).Execute(context.Background(), model.TaskItem{ID: 1, Params: params})
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if result.Status != tt.wantStatus {
+				t.Errorf("status = %s (%s), want %s", result.Status, result.Message, tt.wantStatus)
+			}
+		})
+	}
+}
+
+// testCertificate 生成 127.0.0.1 的自签名证书，有效期为 validFor
+func testCertificate(t *testing.T, validFor time.Duration) tls.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(validFor),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
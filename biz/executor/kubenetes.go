@@ -65,6 +65,34 @@ func (e *KubernetesExecutor) Name() string {
 	return "kubernetes"
 }
 
+// Schema 执行器参数说明
+func (e *KubernetesExecutor) Schema() Schema {
+	return Schema{
+		Name:        e.Name(),
+		Description: "检查 Kubernetes 集群中节点、Pod、Deployment、Service 的状态和 TLS Secret 中证书的有效期",
+		Params: []ParamSchema{
+			{Name: "operation", Type: ParamTypeString, Required: true, Enum: []string{"get_nodes", "get_pods", "check_deployments", "check_services", "check_tls_secrets"}, Description: "检查操作"},
+			{Name: "namespace", Type: ParamTypeString, Default: "default", Operations: []string{"get_pods", "check_deployments", "check_services", "check_tls_secrets"}, Description: "命名空间，check_tls_secrets 支持逗号分隔多个命名空间，* 表示所有命名空间"},
+			{Name: "label_selector", Type: ParamTypeString, Operations: []string{"get_pods", "check_tls_secrets"}, Description: "Pod 或 Secret 的标签选择器"},
+			{Name: "deployment", Type: ParamTypeString, Operations: []string{"check_deployments"}, Description: "只检查指定的 Deployment，为空时检查命名空间下所有 Deployment"},
+			{Name: "service", Type: ParamTypeString, Operations: []string{"check_services"}, Description: "只检查指定的 Service，为空时检查命名空间下所有 Service"},
+			{Name: "warning_days", Type: ParamTypeInteger, Default: "30", Operations: []string{"check_tls_secrets"}, Description: "证书剩余有效期少于该天数时为警告"},
+			{Name: "critical_days", Type: ParamTypeInteger, Default: "7", Operations: []string{"check_tls_secrets"}, Description: "证书剩余有效期少于该天数时为严重"},
+		},
+	}
+}
+
+// Probe 检查 Kubernetes API Server 是否可达
+func (e *KubernetesExecutor) Probe(ctx context.Context, item model.TaskItem) error {
+	probeCtx, cancel := context.WithTimeout(ctx, itemTimeout(item, e.config.Timeout, 30))
+	defer cancel()
+
+	if _, err := e.clientset.Discovery().RESTClient().Get().AbsPath("/version").DoRaw(probeCtx); err != nil {
+		return fmt.Errorf("访问 Kubernetes API Server 失败: %v", err)
+	}
+	return nil
+}
+
 // Execute 执行巡检项
 func (e *KubernetesExecutor) Execute(ctx context.Context, item model.TaskItem) (model.TaskResult, error) {
 	startTime := time.Now()
@@ -83,11 +111,7 @@ func (e *KubernetesExecutor) Execute(ctx context.Context, item model.TaskItem) (
 	}
 
 	// 设置超时上下文
-	timeout := e.config.Timeout
-	if timeout <= 0 {
-		timeout = 30
-	}
-	execCtx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+	execCtx, cancel := context.WithTimeout(ctx, itemTimeout(item, e.config.Timeout, 30))
 	defer cancel()
 
 	// 根据操作类型执行不同的操作
@@ -100,6 +124,8 @@ func (e *KubernetesExecutor) Execute(ctx context.Context, item model.TaskItem) (
 		return e.checkDeployments(execCtx, item, startTime)
 	case "check_services":
 		return e.checkServices(execCtx, item, startTime)
+	case "check_tls_secrets":
+		return e.checkTLSSecrets(execCtx, item, startTime)
 	default:
 		result.Status = model.ResultStatusFailed
 		result.Message = fmt.Sprintf("Unsupported operation: %s", operation)
@@ -0,0 +1,242 @@
+package executor
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// tlsCertReport TLS Secret 中单个证书的检查结果
+type tlsCertReport struct {
+	Position string // leaf 为叶子证书，chain[n] 为证书链中的第 n 个中间证书
+	Subject  string
+	SANs     []string
+	NotAfter time.Time
+	DaysLeft int
+	Status   model.ResultStatus
+}
+
+// tlsSecretReport 单个 TLS Secret 的检查结果
+type tlsSecretReport struct {
+	Namespace string
+	Name      string
+	Certs     []tlsCertReport
+	Problems  []string // 证书无法解析、证书与私钥不匹配等问题，均为严重
+	Status    model.ResultStatus
+}
+
+// checkTLSSecrets 检查 kubernetes.io/tls 类型 Secret 中证书的有效期和私钥是否匹配
+func (e *KubernetesExecutor) checkTLSSecrets(ctx context.Context, item model.TaskItem, startTime time.Time) (model.TaskResult, error) {
+	result := model.TaskResult{
+		ItemID: item.ID,
+		Status: model.ResultStatusNormal,
+	}
+
+	warningDays, ok := paramFloat(item, "warning_days")
+	if !ok {
+		warningDays = 30
+	}
+	criticalDays, ok := paramFloat(item, "critical_days")
+	if !ok {
+		criticalDays = 7
+	}
+	labelSelector, _ := item.Params["label_selector"].(string)
+
+	now := time.Now()
+	var reports []tlsSecretReport
+	for _, namespace := range tlsNamespaces(paramString(item, "namespace")) {
+		secrets, err := e.clientset.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{
+			FieldSelector: "type=" + string(corev1.SecretTypeTLS),
+			LabelSelector: labelSelector,
+		})
+		if err != nil {
+			result.Status = model.ResultStatusFailed
+			result.Message = fmt.Sprintf("Failed to list secrets: %v", err)
+			result.Duration = time.Since(startTime).Milliseconds()
+			return result, err
+		}
+		for i := range secrets.Items {
+			reports = append(reports, inspectTLSSecret(&secrets.Items[i], now, warningDays, criticalDays))
+		}
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		if reports[i].Namespace != reports[j].Namespace {
+			return reports[i].Namespace < reports[j].Namespace
+		}
+		return reports[i].Name < reports[j].Name
+	})
+
+	// 汇总即将过期的证书和存在问题的 Secret
+	var expiring, broken int
+	var soonest *tlsCertReport
+	var soonestSecret string
+	var details []string
+	for i := range reports {
+		report := &reports[i]
+		if report.Status == model.ResultStatusCritical || (report.Status == model.ResultStatusWarning && result.Status == model.ResultStatusNormal) {
+			result.Status = report.Status
+		}
+		if len(report.Problems) > 0 {
+			broken++
+		}
+		for _, problem := range report.Problems {
+			details = append(details, fmt.Sprintf("[%s] %s/%s: %s", model.ResultStatusCritical, report.Namespace, report.Name, problem))
+		}
+		for j := range report.Certs {
+			cert := &report.Certs[j]
+			if soonest == nil || cert.NotAfter.Before(soonest.NotAfter) {
+				soonest = cert
+				soonestSecret = report.Namespace + "/" + report.Name
+			}
+			if cert.Status == model.ResultStatusNormal {
+				continue
+			}
+			expiring++
+			details = append(details, fmt.Sprintf("[%s] %s/%s %s: subject=%s, SANs=%s, 到期 %s (剩余 %d 天)",
+				cert.Status, report.Namespace, report.Name, cert.Position, cert.Subject,
+				strings.Join(cert.SANs, ","), cert.NotAfter.Format(time.RFC3339), cert.DaysLeft))
+		}
+	}
+
+	result.Labels = map[string]string{
+		"secrets":  strconv.Itoa(len(reports)),
+		"expiring": strconv.Itoa(expiring),
+		"broken":   strconv.Itoa(broken),
+	}
+	if soonest != nil {
+		// 结果值为最近到期证书的剩余天数，便于按历史结果观察变化
+		result.Value = strconv.Itoa(soonest.DaysLeft)
+		result.Labels["soonest_secret"] = soonestSecret
+	}
+
+	switch {
+	case expiring > 0 || broken > 0:
+		result.Message = fmt.Sprintf("检查 %d 个 TLS Secret，%d 个证书将在 %.0f 天内过期或已过期，%d 个 Secret 的证书无法解析或与私钥不匹配",
+			len(reports), expiring, warningDays, broken)
+	default:
+		result.Message = fmt.Sprintf("检查 %d 个 TLS Secret，所有证书剩余有效期都超过 %.0f 天", len(reports), warningDays)
+	}
+
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("Secrets: %d\n", len(reports)))
+	if soonest != nil {
+		builder.WriteString(fmt.Sprintf("Soonest: %s %s, expires %s (%d days left)\n",
+			soonestSecret, soonest.Subject, soonest.NotAfter.Format(time.RFC3339), soonest.DaysLeft))
+	}
+	if len(details) > 0 {
+		builder.WriteString("\n")
+		builder.WriteString(strings.Join(details, "\n"))
+	}
+	result.Details = builder.String()
+	result.Duration = time.Since(startTime).Milliseconds()
+	return result, nil
+}
+
+// tlsNamespaces 解析逗号分隔的命名空间，* 表示所有命名空间
+func tlsNamespaces(s string) []string {
+	var namespaces []string
+	for _, namespace := range strings.Split(s, ",") {
+		namespace = strings.TrimSpace(namespace)
+		if namespace == "*" {
+			return []string{metav1.NamespaceAll}
+		}
+		if namespace != "" {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	if len(namespaces) == 0 {
+		return []string{metav1.NamespaceDefault}
+	}
+	return namespaces
+}
+
+// inspectTLSSecret 解析 Secret 中的证书链，检查每个证书的剩余有效期以及叶子证书与私钥是否匹配
+func inspectTLSSecret(secret *corev1.Secret, now time.Time, warningDays, criticalDays float64) tlsSecretReport {
+	report := tlsSecretReport{
+		Namespace: secret.Namespace,
+		Name:      secret.Name,
+		Status:    model.ResultStatusNormal,
+	}
+
+	certPEM := secret.Data[corev1.TLSCertKey]
+	rest := certPEM
+	index := 0 // 证书块的序号，包括解析失败的证书，保证位置与 Secret 中的顺序一致
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		position := "leaf"
+		if index > 0 {
+			position = fmt.Sprintf("chain[%d]", index)
+		}
+		index++
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			report.Problems = append(report.Problems, fmt.Sprintf("%s 证书解析失败: %v", position, err))
+			continue
+		}
+
+		daysLeft := int(cert.NotAfter.Sub(now).Hours() / 24)
+		status := model.ResultStatusNormal
+		if float64(daysLeft) < criticalDays {
+			status = model.ResultStatusCritical
+		} else if float64(daysLeft) < warningDays {
+			status = model.ResultStatusWarning
+		}
+		report.Certs = append(report.Certs, tlsCertReport{
+			Position: position,
+			Subject:  cert.Subject.String(),
+			SANs:     certSANs(cert),
+			NotAfter: cert.NotAfter,
+			DaysLeft: daysLeft,
+			Status:   status,
+		})
+		if status == model.ResultStatusCritical || (status == model.ResultStatusWarning && report.Status == model.ResultStatusNormal) {
+			report.Status = status
+		}
+	}
+
+	switch {
+	case len(report.Certs) == 0 && len(report.Problems) == 0:
+		report.Problems = append(report.Problems, fmt.Sprintf("%s 中没有证书", corev1.TLSCertKey))
+	case len(secret.Data[corev1.TLSPrivateKeyKey]) == 0:
+		report.Problems = append(report.Problems, fmt.Sprintf("缺少私钥 %s", corev1.TLSPrivateKeyKey))
+	case len(report.Certs) > 0:
+		// X509KeyPair 校验叶子证书的公钥与私钥是否匹配
+		if _, err := tls.X509KeyPair(certPEM, secret.Data[corev1.TLSPrivateKeyKey]); err != nil {
+			report.Problems = append(report.Problems, fmt.Sprintf("证书与私钥不匹配: %v", err))
+		}
+	}
+	if len(report.Problems) > 0 {
+		report.Status = model.ResultStatusCritical
+	}
+	return report
+}
+
+// certSANs 返回证书的所有 SAN
+func certSANs(cert *x509.Certificate) []string {
+	sans := append([]string{}, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+	for _, uri := range cert.URIs {
+		sans = append(sans, uri.String())
+	}
+	return append(sans, cert.EmailAddresses...)
+}
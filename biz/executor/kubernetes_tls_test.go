@@ -0,0 +1,41 @@
+package executor
+
+import (
+	"encoding/pem"
+	"strings"
+	"testing"
+	"time"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestInspectTLSSecretPositions(t *testing.T) {
+	chain := testCertificate(t, 20*24*time.Hour)
+	var certPEM []byte
+	certPEM = append(certPEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("broken leaf")})...)
+	certPEM = append(certPEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: chain.Certificate[0]})...)
+
+	secret := &corev1.Secret{
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       certPEM,
+			corev1.TLSPrivateKeyKey: []byte("key"),
+		},
+	}
+	report := inspectTLSSecret(secret, time.Now(), 30, 7)
+
+	if len(report.Problems) == 0 || !strings.HasPrefix(report.Problems[0], "leaf 证书解析失败") {
+		t.Errorf("problems = %q, want leaf parse failure first", report.Problems)
+	}
+	if len(report.Certs) != 1 || report.Certs[0].Position != "chain[1]" {
+		t.Fatalf("certs = %+v, want one cert at chain[1]", report.Certs)
+	}
+	if report.Certs[0].Status != model.ResultStatusWarning {
+		t.Errorf("chain[1] status = %s, want warning", report.Certs[0].Status)
+	}
+	if report.Status != model.ResultStatusCritical {
+		t.Errorf("report status = %s, want critical", report.Status)
+	}
+}
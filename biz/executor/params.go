@@ -0,0 +1,48 @@
+package executor
+
+import (
+	"strconv"
+	"strings"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+)
+
+// paramString 读取字符串参数，数字参数转换为字符串
+func paramString(item model.TaskItem, name string) string {
+	switch v := item.Params[name].(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return ""
+	}
+}
+
+// paramFloat 读取数值参数，参数不存在或不是数字时 ok 为 false
+func paramFloat(item model.TaskItem, name string) (value float64, ok bool) {
+	switch v := item.Params[name].(type) {
+	case float64:
+		return v, true
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// paramBool 读取布尔参数，参数不存在或无法解析时返回默认值
+func paramBool(item model.TaskItem, name string, defaultValue bool) bool {
+	switch v := item.Params[name].(type) {
+	case bool:
+		return v
+	case string:
+		if b, err := strconv.ParseBool(strings.TrimSpace(v)); err == nil {
+			return b
+		}
+	}
+	return defaultValue
+}
@@ -0,0 +1,44 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+)
+
+// Prober 支持连通性检查的执行器，试运行时只检查目标是否可达，不执行查询或命令
+type Prober interface {
+	// Probe 检查任务项的目标是否可达
+	Probe(ctx context.Context, item model.TaskItem) error
+}
+
+// probeHTTP 发送 GET 请求检查 HTTP 服务是否可达，认证失败和服务端错误视为不可达
+func probeHTTP(ctx context.Context, client *http.Client, url string, header http.Header) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("创建请求失败: %v", err)
+	}
+	for key, values := range header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("请求 %s 失败: %v", url, err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return fmt.Errorf("请求 %s 认证失败: %s", url, resp.Status)
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return fmt.Errorf("请求 %s 返回错误: %s", url, resp.Status)
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package executor
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
@@ -45,6 +46,30 @@ func (e *PrometheusExecutor) Name() string {
 	return "prometheus"
 }
 
+// Target 返回 Prometheus 地址，所有任务项共用同一个后端
+func (e *PrometheusExecutor) Target(item model.TaskItem) string {
+	return e.config.URL
+}
+
+// Schema 执行器参数说明
+func (e *PrometheusExecutor) Schema() Schema {
+	return Schema{
+		Name:        e.Name(),
+		Description: "执行 PromQL 即时查询，并按阈值判断结果",
+		Params: []ParamSchema{
+			{Name: "query", Type: ParamTypeString, Required: true, Description: "PromQL 查询语句"},
+			{Name: "threshold", Type: ParamTypeNumber, Description: "告警阈值，查询结果大于阈值时为警告"},
+		},
+	}
+}
+
+// Probe 检查 Prometheus 是否就绪
+func (e *PrometheusExecutor) Probe(ctx context.Context, item model.TaskItem) error {
+	probeCtx, cancel := context.WithTimeout(ctx, itemTimeout(item, e.config.Timeout, 10))
+	defer cancel()
+	return probeHTTP(probeCtx, http.DefaultClient, strings.TrimSuffix(e.config.URL, "/")+"/-/ready", nil)
+}
+
 // Execute 执行巡检项
 func (e *PrometheusExecutor) Execute(ctx context.Context, item model.TaskItem) (model.TaskResult, error) {
 	startTime := time.Now()
@@ -63,11 +88,7 @@ func (e *PrometheusExecutor) Execute(ctx context.Context, item model.TaskItem) (
 	}
 
 	// 设置超时上下文
-	timeout := e.config.Timeout
-	if timeout <= 0 {
-		timeout = 10
-	}
-	queryCtx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+	queryCtx, cancel := context.WithTimeout(ctx, itemTimeout(item, e.config.Timeout, 10))
 	defer cancel()
 
 	// 执行查询
@@ -93,6 +114,8 @@ func (e *PrometheusExecutor) Execute(ctx context.Context, item model.TaskItem) (
 		return result, err
 	}
 	hlog.Infof("Query result: %s", value)
+	result.Value = value
+	result.Labels = prometheusResultLabels(queryResult)
 
 	// 检查阈值
 	if thresholdStr, ok := item.Params["threshold"].(string); ok {
@@ -158,6 +181,30 @@ func (e *PrometheusExecutor) Execute(ctx context.Context, item model.TaskItem) (
 	return result, nil
 }
 
+// prometheusResultLabels 返回结果值对应样本的标签，即第一个样本或时间序列的标签
+func prometheusResultLabels(value pmodel.Value) map[string]string {
+	var metric pmodel.Metric
+	switch v := value.(type) {
+	case pmodel.Vector:
+		if len(v) > 0 {
+			metric = v[0].Metric
+		}
+	case pmodel.Matrix:
+		if len(v) > 0 {
+			metric = v[0].Metric
+		}
+	}
+	if len(metric) == 0 {
+		return nil
+	}
+
+	labels := make(map[string]string, len(metric))
+	for k, v := range metric {
+		labels[string(k)] = string(v)
+	}
+	return labels
+}
+
 // parsePrometheusResult 解析 Prometheus 查询结果
 func parsePrometheusResult(value pmodel.Value) (string, map[string]string, error) {
 	allValues := make(map[string]string)
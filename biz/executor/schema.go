@@ -0,0 +1,168 @@
+package executor
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ParamType 执行器参数类型
+type ParamType string
+
+const (
+	// ParamTypeString 字符串
+	ParamTypeString ParamType = "string"
+	// ParamTypeInteger 整数，可以是数字或数字字符串
+	ParamTypeInteger ParamType = "integer"
+	// ParamTypeNumber 数值，可以是数字或数字字符串
+	ParamTypeNumber ParamType = "number"
+	// ParamTypeBoolean 布尔值，可以是 true/false 或对应字符串
+	ParamTypeBoolean ParamType = "boolean"
+	// ParamTypeRegex 正则表达式字符串，提交时检查能否编译
+	ParamTypeRegex ParamType = "regex"
+)
+
+// ParamSchema 执行器参数说明
+type ParamSchema struct {
+	Name        string    `json:"name"`
+	Type        ParamType `json:"type"`
+	Required    bool      `json:"required,omitempty"`
+	Default     string    `json:"default,omitempty"`     // 未提供参数时使用的默认值
+	Enum        []string  `json:"enum,omitempty"`        // 允许的取值
+	Operations  []string  `json:"operations,omitempty"`  // 只在这些 operation 下使用，为空时对所有 operation 生效
+	Secret      bool      `json:"secret,omitempty"`      // 是否为敏感参数，展示时隐藏取值
+	Description string    `json:"description,omitempty"` // 参数说明
+}
+
+// Schema 执行器自描述，说明执行器接受的参数
+type Schema struct {
+	Name        string        `json:"name"`
+	Description string        `json:"description,omitempty"`
+	Params      []ParamSchema `json:"params"`
+}
+
+// IsSecret 参数是否为敏感参数
+func (s Schema) IsSecret(name string) bool {
+	for _, param := range s.Params {
+		if param.Name == name {
+			return param.Secret
+		}
+	}
+	return false
+}
+
+// appliesTo 参数是否适用于指定的 operation
+func (p ParamSchema) appliesTo(operation string) bool {
+	if len(p.Operations) == 0 {
+		return true
+	}
+	for _, op := range p.Operations {
+		if strings.EqualFold(op, operation) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate 按参数说明校验任务项参数，缺少的参数使用默认值补齐，返回所有校验错误。
+// 未在说明中声明的参数不做校验，原样传给执行器
+func (s Schema) Validate(params map[string]interface{}) []error {
+	return s.ValidateDeferred(params, nil)
+}
+
+// ValidateDeferred 与 Validate 相同，但 deferred 中的参数视为已提供且不校验取值，
+// 用于取值在执行时才能确定的参数
+func (s Schema) ValidateDeferred(params map[string]interface{}, deferred map[string]bool) []error {
+	var errs []error
+
+	operation, _ := params["operation"].(string)
+	for _, param := range s.Params {
+		if !param.appliesTo(operation) || deferred[param.Name] {
+			continue
+		}
+
+		value, exists := params[param.Name]
+		if !exists || value == nil || value == "" {
+			if param.Default != "" {
+				params[param.Name] = param.Default
+				continue
+			}
+			if param.Required {
+				errs = append(errs, fmt.Errorf("缺少必填参数 %s", param.Name))
+			}
+			continue
+		}
+
+		if err := param.check(value); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// check 校验参数值的类型和取值范围
+func (p ParamSchema) check(value interface{}) error {
+	s, isString := value.(string)
+
+	switch p.Type {
+	case ParamTypeInteger:
+		switch v := value.(type) {
+		case string:
+			if _, err := strconv.Atoi(strings.TrimSpace(v)); err != nil {
+				return fmt.Errorf("参数 %s 应为整数: %q", p.Name, v)
+			}
+		case float64:
+			if v != float64(int64(v)) {
+				return fmt.Errorf("参数 %s 应为整数: %v", p.Name, v)
+			}
+		case int, int64:
+		default:
+			return fmt.Errorf("参数 %s 应为整数: %v", p.Name, value)
+		}
+	case ParamTypeNumber:
+		switch v := value.(type) {
+		case string:
+			if _, err := strconv.ParseFloat(strings.TrimSpace(v), 64); err != nil {
+				return fmt.Errorf("参数 %s 应为数值: %q", p.Name, v)
+			}
+		case float64, int, int64:
+		default:
+			return fmt.Errorf("参数 %s 应为数值: %v", p.Name, value)
+		}
+	case ParamTypeBoolean:
+		switch v := value.(type) {
+		case string:
+			if _, err := strconv.ParseBool(strings.TrimSpace(v)); err != nil {
+				return fmt.Errorf("参数 %s 应为布尔值: %q", p.Name, v)
+			}
+		case bool:
+		default:
+			return fmt.Errorf("参数 %s 应为布尔值: %v", p.Name, value)
+		}
+	case ParamTypeRegex:
+		if !isString {
+			return fmt.Errorf("参数 %s 应为字符串: %v", p.Name, value)
+		}
+		if _, err := regexp.Compile(s); err != nil {
+			return fmt.Errorf("参数 %s 不是有效的正则表达式: %v", p.Name, err)
+		}
+	default:
+		if !isString {
+			return fmt.Errorf("参数 %s 应为字符串: %v", p.Name, value)
+		}
+	}
+
+	if len(p.Enum) > 0 {
+		for _, allowed := range p.Enum {
+			if strings.EqualFold(allowed, fmt.Sprint(value)) {
+				return nil
+			}
+		}
+		if isString {
+			return fmt.Errorf("参数 %s 的取值 %q 无效，可选值: %s", p.Name, s, strings.Join(p.Enum, ", "))
+		}
+		return fmt.Errorf("参数 %s 的取值 %v 无效，可选值: %s", p.Name, value, strings.Join(p.Enum, ", "))
+	}
+	return nil
+}
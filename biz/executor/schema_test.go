@@ -0,0 +1,177 @@
+package executor
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSchemaValidate(t *testing.T) {
+	schema := Schema{
+		Name: "test",
+		Params: []ParamSchema{
+			{Name: "host", Type: ParamTypeString, Required: true},
+			{Name: "port", Type: ParamTypeInteger, Default: "22"},
+			{Name: "ratio", Type: ParamTypeNumber},
+			{Name: "verbose", Type: ParamTypeBoolean},
+			{Name: "pattern", Type: ParamTypeRegex},
+			{Name: "operation", Type: ParamTypeString, Enum: []string{"ping", "query"}},
+			{Name: "query", Type: ParamTypeString, Required: true, Operations: []string{"query"}},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		params     map[string]interface{}
+		deferred   map[string]bool
+		wantErrs   []string
+		wantParams map[string]interface{}
+	}{
+		{
+			name:       "valid with default",
+			params:     map[string]interface{}{"host": "a", "operation": "ping"},
+			wantParams: map[string]interface{}{"port": "22"},
+		},
+		{
+			name:       "empty value uses default",
+			params:     map[string]interface{}{"host": "a", "port": ""},
+			wantParams: map[string]interface{}{"port": "22"},
+		},
+		{
+			name:       "provided value is kept",
+			params:     map[string]interface{}{"host": "a", "port": float64(2222)},
+			wantParams: map[string]interface{}{"port": float64(2222)},
+		},
+		{
+			name:     "missing required",
+			params:   map[string]interface{}{},
+			wantErrs: []string{"缺少必填参数 host"},
+		},
+		{
+			name:     "nil required",
+			params:   map[string]interface{}{"host": nil},
+			wantErrs: []string{"缺少必填参数 host"},
+		},
+		{
+			name:   "numeric strings",
+			params: map[string]interface{}{"host": "a", "port": " 80 ", "ratio": "0.5", "verbose": "true"},
+		},
+		{
+			name:   "native types",
+			params: map[string]interface{}{"host": "a", "port": 80, "ratio": 1, "verbose": false},
+		},
+		{
+			name:     "integer string",
+			params:   map[string]interface{}{"host": "a", "port": "eighty"},
+			wantErrs: []string{"参数 port 应为整数"},
+		},
+		{
+			name:     "fractional integer",
+			params:   map[string]interface{}{"host": "a", "port": 80.5},
+			wantErrs: []string{"参数 port 应为整数"},
+		},
+		{
+			name:     "invalid number",
+			params:   map[string]interface{}{"host": "a", "ratio": "half"},
+			wantErrs: []string{"参数 ratio 应为数值"},
+		},
+		{
+			name:     "invalid boolean",
+			params:   map[string]interface{}{"host": "a", "verbose": "maybe"},
+			wantErrs: []string{"参数 verbose 应为布尔值"},
+		},
+		{
+			name:     "string type mismatch",
+			params:   map[string]interface{}{"host": 1},
+			wantErrs: []string{"参数 host 应为字符串"},
+		},
+		{
+			name:   "valid regex",
+			params: map[string]interface{}{"host": "a", "pattern": `^SSH-2\.0`},
+		},
+		{
+			name:     "invalid regex",
+			params:   map[string]interface{}{"host": "a", "pattern": "(a"},
+			wantErrs: []string{"参数 pattern 不是有效的正则表达式"},
+		},
+		{
+			name:     "regex type mismatch",
+			params:   map[string]interface{}{"host": "a", "pattern": 1},
+			wantErrs: []string{"参数 pattern 应为字符串"},
+		},
+		{
+			name:   "enum is case insensitive",
+			params: map[string]interface{}{"host": "a", "operation": "PING"},
+		},
+		{
+			name:     "enum mismatch",
+			params:   map[string]interface{}{"host": "a", "operation": "delete"},
+			wantErrs: []string{"取值 \"delete\" 无效，可选值: ping, query"},
+		},
+		{
+			name:     "operation specific required",
+			params:   map[string]interface{}{"host": "a", "operation": "query"},
+			wantErrs: []string{"缺少必填参数 query"},
+		},
+		{
+			name:   "operation specific param ignored",
+			params: map[string]interface{}{"host": "a", "operation": "ping", "query": 1},
+		},
+		{
+			name:     "all errors reported",
+			params:   map[string]interface{}{"port": "x", "verbose": 1},
+			wantErrs: []string{"缺少必填参数 host", "参数 port 应为整数", "参数 verbose 应为布尔值"},
+		},
+		{
+			name:     "deferred param is not checked",
+			params:   map[string]interface{}{"host": "a", "port": "${items.1.value}", "operation": "query"},
+			deferred: map[string]bool{"port": true, "query": true},
+		},
+		{
+			name:       "undeclared param passes through",
+			params:     map[string]interface{}{"host": "a", "extra": true},
+			wantParams: map[string]interface{}{"extra": true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := schema.ValidateDeferred(tt.params, tt.deferred)
+			if len(errs) != len(tt.wantErrs) {
+				t.Fatalf("ValidateDeferred() errors = %v, want %q", errs, tt.wantErrs)
+			}
+			for i, want := range tt.wantErrs {
+				if !strings.Contains(errs[i].Error(), want) {
+					t.Errorf("error[%d] = %q, want %q", i, errs[i], want)
+				}
+			}
+			for name, want := range tt.wantParams {
+				if got := tt.params[name]; got != want {
+					t.Errorf("params[%s] = %v, want %v", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestSchemaValidateDeferredDefault(t *testing.T) {
+	schema := Schema{Params: []ParamSchema{{Name: "port", Type: ParamTypeInteger, Default: "22"}}}
+	params := map[string]interface{}{}
+	if errs := schema.ValidateDeferred(params, map[string]bool{"port": true}); len(errs) > 0 {
+		t.Fatalf("ValidateDeferred() errors = %v", errors.Join(errs...))
+	}
+	if _, ok := params["port"]; ok {
+		t.Errorf("deferred param got default %v", params["port"])
+	}
+}
+
+func TestSchemaIsSecret(t *testing.T) {
+	schema := Schema{Params: []ParamSchema{
+		{Name: "password", Type: ParamTypeString, Secret: true},
+		{Name: "user", Type: ParamTypeString},
+	}}
+	for name, want := range map[string]bool{"password": true, "user": false, "unknown": false} {
+		if got := schema.IsSecret(name); got != want {
+			t.Errorf("IsSecret(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,299 @@
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+	"github.mokaz111.com/candy-agent/conf"
+)
+
+// defaultMaxOutputBytes 未配置时标准输出和标准错误各自保留的最大字节数
+const defaultMaxOutputBytes = 64 * 1024
+
+// ShellExecutor 在 Agent 容器内执行命令的执行器
+type ShellExecutor struct {
+	config conf.ShellConfig
+}
+
+// NewShellExecutor 创建 shell 执行器
+func NewShellExecutor(config conf.ShellConfig) *ShellExecutor {
+	return &ShellExecutor{config: config}
+}
+
+// Name 执行器名称
+func (e *ShellExecutor) Name() string {
+	return "shell"
+}
+
+// Schema 执行器参数说明
+func (e *ShellExecutor) Schema() Schema {
+	return Schema{
+		Name:        e.Name(),
+		Description: "在 Agent 容器内执行命令",
+		Params: []ParamSchema{
+			{Name: "command", Type: ParamTypeString, Required: true, Description: "要执行的命令，由配置的 shell 以 -c 方式执行"},
+			{Name: "workdir", Type: ParamTypeString, Description: "命令启动时所在的目录，相对于配置的工作目录根路径，不能超出根路径；不限制命令访问其他目录"},
+			{Name: "env", Type: ParamTypeString, Description: "额外的环境变量，JSON 对象，变量名需要在配置的允许列表中"},
+			{Name: "threshold", Type: ParamTypeString, Description: "输出包含该字符串时为警告"},
+		},
+	}
+}
+
+// Probe 检查 shell 可执行文件和工作目录是否存在
+func (e *ShellExecutor) Probe(ctx context.Context, item model.TaskItem) error {
+	if _, err := exec.LookPath(e.shell()); err != nil {
+		return fmt.Errorf("找不到 shell %s: %v", e.shell(), err)
+	}
+	dir, err := e.workDir(paramString(item, "workdir"), false)
+	if err != nil {
+		return err
+	}
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		return fmt.Errorf("工作目录 %s 不存在", dir)
+	}
+	return nil
+}
+
+// Execute 执行巡检项
+func (e *ShellExecutor) Execute(ctx context.Context, item model.TaskItem) (model.TaskResult, error) {
+	startTime := time.Now()
+	result := model.TaskResult{
+		ItemID: item.ID,
+		Status: model.ResultStatusNormal,
+	}
+
+	command := paramString(item, "command")
+	if command == "" {
+		result.Status = model.ResultStatusFailed
+		result.Message = "Missing command parameter"
+		result.Duration = time.Since(startTime).Milliseconds()
+		return result, fmt.Errorf("missing command parameter")
+	}
+
+	dir, err := e.workDir(paramString(item, "workdir"), true)
+	if err != nil {
+		result.Status = model.ResultStatusFailed
+		result.Message = fmt.Sprintf("工作目录无效: %v", err)
+		result.Duration = time.Since(startTime).Milliseconds()
+		return result, err
+	}
+
+	env, err := e.environ(paramString(item, "env"))
+	if err != nil {
+		result.Status = model.ResultStatusFailed
+		result.Message = err.Error()
+		result.Duration = time.Since(startTime).Milliseconds()
+		return result, err
+	}
+
+	// CPU 时间限制通过 shell 的 ulimit 设置，对命令及其子进程生效。
+	// 超过软限制时收到 SIGXCPU，硬限制多留1秒，忽略 SIGXCPU 的命令在硬限制时被 SIGKILL 结束
+	script := command
+	if e.config.CPUTime > 0 {
+		script = fmt.Sprintf("ulimit -S -t %d && ulimit -H -t %d || exit 126\n%s", e.config.CPUTime, e.config.CPUTime+1, command)
+	}
+
+	maxOutput := e.config.MaxOutputBytes
+	if maxOutput <= 0 {
+		maxOutput = defaultMaxOutputBytes
+	}
+	stdout := &limitedBuffer{limit: maxOutput}
+	stderr := &limitedBuffer{limit: maxOutput}
+
+	timeout := itemTimeout(item, e.config.Timeout, 30)
+	execCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(execCtx, e.shell(), "-c", script)
+	cmd.Dir = dir
+	cmd.Env = env
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	// 上下文结束时结束整个进程组，后台子进程持有输出管道时最多再等待一秒
+	setProcessGroupKill(cmd)
+	cmd.WaitDelay = time.Second
+
+	runErr := cmd.Run()
+	exitCode := -1
+	if state := cmd.ProcessState; state != nil {
+		exitCode = state.ExitCode()
+		if runErr != nil && e.config.CPUTime > 0 && cpuLimitExceeded(state, time.Duration(e.config.CPUTime)*time.Second) {
+			runErr = fmt.Errorf("超过 CPU 时间限制 %ds: %v", e.config.CPUTime, runErr)
+		}
+	}
+
+	switch {
+	case ctx.Err() != nil:
+		result.Status = model.ResultStatusFailed
+		result.Message = "Command execution cancelled"
+		result.Duration = time.Since(startTime).Milliseconds()
+		return result, ctx.Err()
+	case errors.Is(execCtx.Err(), context.DeadlineExceeded):
+		result.Status = model.ResultStatusFailed
+		result.Message = fmt.Sprintf("Command execution timed out after %s", timeout)
+		result.Duration = time.Since(startTime).Milliseconds()
+		return result, fmt.Errorf("command execution timed out")
+	}
+
+	applyCommandResult(&result, item, stdout.String(), stderr.String(), exitCode, runErr)
+
+	// 设置详细信息
+	var details strings.Builder
+	details.WriteString(fmt.Sprintf("Command: %s\n", command))
+	details.WriteString(fmt.Sprintf("Workdir: %s\n", dir))
+	details.WriteString(fmt.Sprintf("Exit Code: %d\n", exitCode))
+	details.WriteString(fmt.Sprintf("Stdout: %s\n", stdout.String()))
+	if stderr.Len() > 0 {
+		details.WriteString(fmt.Sprintf("Stderr: %s\n", stderr.String()))
+	}
+	if stdout.truncated || stderr.truncated {
+		details.WriteString(fmt.Sprintf("Output truncated to %d bytes\n", maxOutput))
+	}
+	result.Details = details.String()
+	result.Duration = time.Since(startTime).Milliseconds()
+
+	return result, nil
+}
+
+// shell 执行命令使用的 shell，默认 /bin/sh
+func (e *ShellExecutor) shell() string {
+	if e.config.Shell != "" {
+		return e.config.Shell
+	}
+	return "/bin/sh"
+}
+
+// workDir 返回任务项的工作目录，相对路径和 .. 都限制在配置的根路径内，
+// 根路径下的符号链接不能指向根路径之外。create 为 true 时创建不存在的目录，
+// 创建前检查已存在的最深一级上级目录，创建后再检查一次，避免经由指向外部的符号链接创建目录。
+// 工作目录只是命令启动时所在的目录，命令仍然可以切换目录或按绝对路径访问 Agent 进程有权限访问的文件
+func (e *ShellExecutor) workDir(sub string, create bool) (string, error) {
+	root := e.config.WorkDir
+	if root == "" {
+		root = "data/shell"
+	}
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return "", err
+	}
+	if root, err = filepath.EvalSymlinks(root); err != nil {
+		return "", err
+	}
+
+	// 找到已存在的最深一级上级目录，解析其中的符号链接
+	dir := filepath.Join(root, filepath.Clean("/"+sub))
+	existing := dir
+	resolved, err := filepath.EvalSymlinks(existing)
+	for os.IsNotExist(err) && existing != root {
+		existing = filepath.Dir(existing)
+		resolved, err = filepath.EvalSymlinks(existing)
+	}
+	if err != nil {
+		return "", err
+	}
+	if !withinDir(resolved, root) {
+		return "", fmt.Errorf("工作目录 %s 超出根路径 %s", sub, root)
+	}
+	if existing == dir {
+		return resolved, nil
+	}
+
+	rest, err := filepath.Rel(existing, dir)
+	if err != nil {
+		return "", err
+	}
+	dir = filepath.Join(resolved, rest)
+	if !create {
+		return "", fmt.Errorf("工作目录 %s 不存在", sub)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+
+	// 创建过程中上级目录可能被替换为符号链接，创建后重新检查
+	if resolved, err = filepath.EvalSymlinks(dir); err != nil {
+		return "", err
+	}
+	if !withinDir(resolved, root) {
+		return "", fmt.Errorf("工作目录 %s 超出根路径 %s", sub, root)
+	}
+	return resolved, nil
+}
+
+// withinDir path 是否为 root 或 root 下的路径，两者都应是已解析符号链接的绝对路径
+func withinDir(path, root string) bool {
+	return path == root || strings.HasPrefix(path, root+string(filepath.Separator))
+}
+
+// environ 返回命令的环境变量：Agent 自身环境中在允许列表内的变量，加上任务项指定的变量
+func (e *ShellExecutor) environ(extra string) ([]string, error) {
+	allowed := make(map[string]bool, len(e.config.EnvAllowlist))
+	for _, name := range e.config.EnvAllowlist {
+		allowed[name] = true
+	}
+
+	var env []string
+	for _, name := range e.config.EnvAllowlist {
+		if value, ok := os.LookupEnv(name); ok {
+			env = append(env, name+"="+value)
+		}
+	}
+
+	if extra == "" {
+		return env, nil
+	}
+	var vars map[string]string
+	if err := json.Unmarshal([]byte(extra), &vars); err != nil {
+		return nil, fmt.Errorf("env 参数应为 JSON 对象: %v", err)
+	}
+	for name, value := range vars {
+		if !allowed[name] {
+			return nil, fmt.Errorf("环境变量 %s 不在允许列表中", name)
+		}
+		env = append(env, name+"="+value)
+	}
+	return env, nil
+}
+
+// limitedBuffer 只保留前 limit 个字节的输出，超出部分丢弃但不报错，避免命令因写入失败退出
+type limitedBuffer struct {
+	buf       []byte
+	limit     int
+	truncated bool
+}
+
+// Write 写入输出
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if remain := b.limit - len(b.buf); remain > 0 {
+		if len(p) > remain {
+			b.buf = append(b.buf, p[:remain]...)
+			b.truncated = true
+		} else {
+			b.buf = append(b.buf, p...)
+		}
+	} else if len(p) > 0 {
+		b.truncated = true
+	}
+	return len(p), nil
+}
+
+// String 返回保留的输出
+func (b *limitedBuffer) String() string {
+	return string(b.buf)
+}
+
+// Len 返回保留的字节数
+func (b *limitedBuffer) Len() int {
+	return len(b.buf)
+}
@@ -0,0 +1,17 @@
+//go:build !unix
+
+package executor
+
+import (
+	"os"
+	"os/exec"
+	"time"
+)
+
+// setProcessGroupKill 不支持进程组的平台上取消时只结束命令进程本身
+func setProcessGroupKill(cmd *exec.Cmd) {}
+
+// cpuLimitExceeded 按命令消耗的 CPU 时间判断是否超过限制
+func cpuLimitExceeded(state *os.ProcessState, limit time.Duration) bool {
+	return state.UserTime()+state.SystemTime() >= limit
+}
@@ -0,0 +1,168 @@
+package executor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+	"github.mokaz111.com/candy-agent/conf"
+)
+
+func TestShellWorkDir(t *testing.T) {
+	root := t.TempDir()
+	outside := t.TempDir()
+	if err := os.Mkdir(filepath.Join(root, "inside"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(outside, filepath.Join(root, "link")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join(root, "inside"), filepath.Join(root, "alias")); err != nil {
+		t.Fatal(err)
+	}
+	resolvedRoot, _ := filepath.EvalSymlinks(root)
+
+	e := NewShellExecutor(conf.ShellConfig{WorkDir: root})
+	tests := []struct {
+		name    string
+		sub     string
+		create  bool
+		wantDir string
+		wantErr string
+	}{
+		{name: "root", sub: "", wantDir: ""},
+		{name: "dotdot stays in root", sub: "../../inside", wantDir: "inside"},
+		{name: "new dir is created", sub: "a/b", create: true, wantDir: "a/b"},
+		{name: "new dir without create", sub: "c/d", wantErr: "不存在"},
+		{name: "symlink inside root", sub: "alias/new", create: true, wantDir: "inside/new"},
+		{name: "symlink escape", sub: "link", wantErr: "超出根路径"},
+		{name: "new dir under symlink escape", sub: "link/new", create: true, wantErr: "超出根路径"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, err := e.workDir(tt.sub, tt.create)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("workDir(%q) error = %v, want %q", tt.sub, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("workDir(%q) error = %v", tt.sub, err)
+			}
+			if want := filepath.Join(resolvedRoot, tt.wantDir); dir != want {
+				t.Errorf("workDir(%q) = %q, want %q", tt.sub, dir, want)
+			}
+		})
+	}
+
+	if _, err := os.Stat(filepath.Join(outside, "new")); !os.IsNotExist(err) {
+		t.Errorf("directory created outside root: %v", err)
+	}
+}
+
+// runShell 在临时工作目录中执行命令
+func runShell(t *testing.T, config conf.ShellConfig, params map[string]interface{}) (model.TaskResult, error) {
+	t.Helper()
+	config.WorkDir = t.TempDir()
+	return NewShellExecutor(config).Execute(context.Background(), model.TaskItem{ID: 1, Type: "shell", Params: params})
+}
+
+func TestShellEnvAllowlist(t *testing.T) {
+	t.Setenv("CANDY_TEST_ALLOWED", "agent")
+	t.Setenv("CANDY_TEST_SECRET", "leaked")
+	config := conf.ShellConfig{EnvAllowlist: []string{"CANDY_TEST_ALLOWED", "CANDY_TEST_EXTRA"}}
+
+	tests := []struct {
+		name       string
+		env        string
+		wantStatus model.ResultStatus
+		wantValue  string
+		wantErr    string
+	}{
+		{name: "only allowlisted agent env", wantStatus: model.ResultStatusNormal, wantValue: "agent--"},
+		{name: "allowlisted extra env", env: `{"CANDY_TEST_EXTRA": "x"}`, wantStatus: model.ResultStatusNormal, wantValue: "agent--x"},
+		{name: "extra env overrides agent env", env: `{"CANDY_TEST_ALLOWED": "item"}`, wantStatus: model.ResultStatusNormal, wantValue: "item--"},
+		{name: "extra env not allowlisted", env: `{"CANDY_TEST_SECRET": "x"}`, wantStatus: model.ResultStatusFailed, wantErr: "环境变量 CANDY_TEST_SECRET 不在允许列表中"},
+		{name: "env is not json", env: "A=1", wantStatus: model.ResultStatusFailed, wantErr: "env 参数应为 JSON 对象"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := runShell(t, config, map[string]interface{}{
+				"command": `printf '%s-%s-%s' "$CANDY_TEST_ALLOWED" "$CANDY_TEST_SECRET" "$CANDY_TEST_EXTRA"`,
+				"env":     tt.env,
+			})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want %q", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if result.Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s (%s)", result.Status, tt.wantStatus, result.Message)
+			}
+			if tt.wantValue != "" && result.Value != tt.wantValue {
+				t.Errorf("value = %q, want %q", result.Value, tt.wantValue)
+			}
+		})
+	}
+}
+
+func TestShellCPUTimeLimit(t *testing.T) {
+	tests := []struct {
+		name    string
+		command string
+	}{
+		{name: "shell loop", command: "while :; do :; done"},
+		{name: "child process", command: "sh -c 'while :; do :; done'"},
+		{name: "ignores SIGXCPU", command: "trap '' XCPU; while :; do :; done"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Now()
+			result, err := runShell(t, conf.ShellConfig{CPUTime: 1, Timeout: 20}, map[string]interface{}{"command": tt.command})
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if result.Status != model.ResultStatusFailed || !strings.Contains(result.Message, "超过 CPU 时间限制 1s") {
+				t.Errorf("result = %s %q, want failed by cpu time limit", result.Status, result.Message)
+			}
+			if elapsed := time.Since(start); elapsed > 10*time.Second {
+				t.Errorf("command ran %v, want killed after about 1s of cpu time", elapsed)
+			}
+		})
+	}
+}
+
+func TestShellOutputTruncated(t *testing.T) {
+	tests := []struct {
+		name       string
+		command    string
+		wantStatus model.ResultStatus
+		wantValue  string
+		truncated  bool
+	}{
+		{name: "stdout", command: "printf 0123456789abcdef; printf err >&2", wantStatus: model.ResultStatusNormal, wantValue: "0123456789", truncated: true},
+		{name: "stderr on failure", command: "printf ok; printf 'error: something broke' >&2; exit 3", wantStatus: model.ResultStatusFailed, wantValue: "error: som", truncated: true},
+		{name: "within limit", command: "printf 0123456789", wantStatus: model.ResultStatusNormal, wantValue: "0123456789"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := runShell(t, conf.ShellConfig{MaxOutputBytes: 10}, map[string]interface{}{"command": tt.command})
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if result.Status != tt.wantStatus || result.Value != tt.wantValue {
+				t.Errorf("result = %s %q, want %s %q", result.Status, result.Value, tt.wantStatus, tt.wantValue)
+			}
+			if got := strings.Contains(result.Details, "Output truncated to 10 bytes"); got != tt.truncated {
+				t.Errorf("details report truncation = %v, want %v:\n%s", got, tt.truncated, result.Details)
+			}
+		})
+	}
+}
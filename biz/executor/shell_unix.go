@@ -0,0 +1,37 @@
+//go:build unix
+
+package executor
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+	"time"
+)
+
+// setProcessGroupKill 让命令在新的进程组中运行，取消时向整个进程组发送 SIGKILL，
+// 避免命令启动的子进程在超时后继续运行
+func setProcessGroupKill(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+}
+
+// cpuLimitExceeded 命令是否因超过 CPU 时间限制被结束：shell 自身收到 SIGXCPU，
+// 忽略 SIGXCPU 后在硬限制时收到 SIGKILL，或者子进程因 SIGXCPU 退出后 shell 以 128+SIGXCPU 退出
+func cpuLimitExceeded(state *os.ProcessState, limit time.Duration) bool {
+	status, ok := state.Sys().(syscall.WaitStatus)
+	if !ok {
+		return false
+	}
+	switch {
+	case status.Signaled() && status.Signal() == syscall.SIGXCPU:
+		return true
+	case status.Signaled() && status.Signal() == syscall.SIGKILL:
+		return state.UserTime()+state.SystemTime() >= limit
+	case status.Exited() && status.ExitStatus() == 128+int(syscall.SIGXCPU):
+		return true
+	}
+	return false
+}
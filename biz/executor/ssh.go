@@ -3,6 +3,7 @@ package executor
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"github.mokaz111.com/candy-agent/biz/model"
 	config "github.mokaz111.com/candy-agent/conf"
@@ -16,13 +17,15 @@ import (
 
 // SSHExecutor SSH 执行器
 type SSHExecutor struct {
-	config config.SSHConfig
+	config   config.SSHConfig
+	hostKeys *hostKeyVerifier
 }
 
 // NewSSHExecutor 创建 SSH 执行器
 func NewSSHExecutor(config config.SSHConfig) *SSHExecutor {
 	return &SSHExecutor{
-		config: config,
+		config:   config,
+		hostKeys: newHostKeyVerifier(config.HostKeyPolicy, config.KnownHostsFile),
 	}
 }
 
@@ -31,6 +34,57 @@ func (e *SSHExecutor) Name() string {
 	return "ssh"
 }
 
+// Schema 执行器参数说明
+func (e *SSHExecutor) Schema() Schema {
+	return Schema{
+		Name:        e.Name(),
+		Description: "通过 SSH 在远程主机上执行命令",
+		Params: []ParamSchema{
+			{Name: "host", Type: ParamTypeString, Required: true, Description: "主机地址"},
+			{Name: "port", Type: ParamTypeInteger, Default: "22", Description: "SSH 端口"},
+			{Name: "username", Type: ParamTypeString, Required: true, Description: "登录用户名"},
+			{Name: "password", Type: ParamTypeString, Secret: true, Description: "登录密码，与 private_key 至少提供一个"},
+			{Name: "private_key", Type: ParamTypeString, Secret: true, Description: "PEM 格式私钥，与 password 至少提供一个"},
+			{Name: "command", Type: ParamTypeString, Required: true, Description: "要执行的命令"},
+			{Name: "threshold", Type: ParamTypeString, Description: "输出包含该字符串时为警告"},
+			{Name: "host_key_fingerprint", Type: ParamTypeString, Description: "主机密钥的 SHA256 指纹，逗号分隔多个，设置后只接受这些密钥，不使用配置的校验策略"},
+		},
+	}
+}
+
+// Target 返回任务项的 SSH 地址
+func (e *SSHExecutor) Target(item model.TaskItem) string {
+	return sshAddress(item)
+}
+
+// sshAddress 任务项的 SSH 地址 host:port
+func sshAddress(item model.TaskItem) string {
+	host, _ := item.Params["host"].(string)
+	port := "22"
+	if p, ok := item.Params["port"].(string); ok && p != "" {
+		port = p
+	} else if p, ok := item.Params["port"].(float64); ok {
+		port = strconv.Itoa(int(p))
+	}
+	return net.JoinHostPort(host, port)
+}
+
+// Probe 检查 SSH 端口是否可以建立 TCP 连接，不登录也不执行命令
+func (e *SSHExecutor) Probe(ctx context.Context, item model.TaskItem) error {
+	address := sshAddress(item)
+
+	timeout := time.Duration(e.config.ConnectionTimeout) * time.Second
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	dialer := net.Dialer{Timeout: timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return fmt.Errorf("连接 %s 失败: %v", address, err)
+	}
+	return conn.Close()
+}
+
 // Execute 执行巡检项
 func (e *SSHExecutor) Execute(ctx context.Context, item model.TaskItem) (model.TaskResult, error) {
 	startTime := time.Now()
@@ -49,8 +103,13 @@ func (e *SSHExecutor) Execute(ctx context.Context, item model.TaskItem) (model.T
 	}
 
 	port := 22
-	if portParam, ok := item.Params["port"].(float64); ok {
+	switch portParam := item.Params["port"].(type) {
+	case float64:
 		port = int(portParam)
+	case string:
+		if p, err := strconv.Atoi(strings.TrimSpace(portParam)); err == nil {
+			port = p
+		}
 	}
 
 	username, ok := item.Params["username"].(string)
@@ -79,12 +138,23 @@ func (e *SSHExecutor) Execute(ctx context.Context, item model.TaskItem) (model.T
 		return result, fmt.Errorf("missing command parameter")
 	}
 
+	// 主机密钥校验
+	address := net.JoinHostPort(host, strconv.Itoa(port))
+	hostKeyCallback, hostKeyAlgorithms, err := e.hostKeys.clientOptions(item, address)
+	if err != nil {
+		result.Status = model.ResultStatusFailed
+		result.Message = err.Error()
+		result.Duration = time.Since(startTime).Milliseconds()
+		return result, err
+	}
+
 	// 创建 SSH 客户端配置
 	clientConfig := &ssh.ClientConfig{
-		User:            username,
-		Auth:            []ssh.AuthMethod{},
-		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
-		Timeout:         time.Duration(e.config.ConnectionTimeout) * time.Second,
+		User:              username,
+		Auth:              []ssh.AuthMethod{},
+		HostKeyCallback:   hostKeyCallback,
+		HostKeyAlgorithms: hostKeyAlgorithms,
+		Timeout:           time.Duration(e.config.ConnectionTimeout) * time.Second,
 	}
 
 	// 添加认证方法
@@ -104,10 +174,21 @@ func (e *SSHExecutor) Execute(ctx context.Context, item model.TaskItem) (model.T
 	}
 
 	// 连接 SSH 服务器
-	client, err := ssh.Dial("tcp", net.JoinHostPort(host, strconv.Itoa(port)), clientConfig)
+	client, err := ssh.Dial("tcp", address, clientConfig)
+	var hkErr *hostKeyError
+	if errors.As(err, &hkErr) && len(hkErr.Want) > 0 {
+		// 主机密钥与记录不一致，可能是中间人攻击，作为巡检发现的严重问题，重试也不会改变结果
+		result.Status = model.ResultStatusCritical
+		result.Final = true
+		result.Message = hkErr.Error()
+		result.Details = fmt.Sprintf("Host: %s\nExpected: %s (%s)\nActual: %s\n", address, strings.Join(hkErr.Want, ", "), hkErr.Source, hkErr.Got)
+		result.Duration = time.Since(startTime).Milliseconds()
+		return result, nil
+	}
 	if err != nil {
 		result.Status = model.ResultStatusFailed
 		result.Message = fmt.Sprintf("Failed to connect to SSH server: %v", err)
+		result.Final = hkErr != nil // 主机不在 known_hosts 中时重试也会失败
 		result.Duration = time.Since(startTime).Milliseconds()
 		return result, err
 	}
@@ -129,10 +210,7 @@ func (e *SSHExecutor) Execute(ctx context.Context, item model.TaskItem) (model.T
 	session.Stderr = &stderr
 
 	// 设置超时上下文
-	timeout := e.config.Timeout
-	if timeout <= 0 {
-		timeout = 30
-	}
+	timeout := itemTimeout(item, e.config.Timeout, 30)
 
 	// 创建一个通道来接收命令完成信号
 	done := make(chan error, 1)
@@ -148,33 +226,22 @@ func (e *SSHExecutor) Execute(ctx context.Context, item model.TaskItem) (model.T
 		result.Message = "Command execution cancelled"
 		result.Duration = time.Since(startTime).Milliseconds()
 		return result, ctx.Err()
-	case <-time.After(time.Duration(timeout) * time.Second):
+	case <-time.After(timeout):
 		// 命令执行超时
 		result.Status = model.ResultStatusFailed
-		result.Message = fmt.Sprintf("Command execution timed out after %d seconds", timeout)
+		result.Message = fmt.Sprintf("Command execution timed out after %s", timeout)
 		result.Duration = time.Since(startTime).Milliseconds()
 		return result, fmt.Errorf("command execution timed out")
 	case err := <-done:
-		// 命令执行完成
+		// 命令执行完成，ExitError 为命令非零退出，其他错误时没有退出码
+		exitCode := 0
 		if err != nil {
-			// 命令执行失败
-			result.Status = model.ResultStatusFailed
-			result.Message = fmt.Sprintf("Command execution failed: %v", err)
-			result.Value = stderr.String()
-		} else {
-			// 命令执行成功
-			result.Value = stdout.String()
-			result.Message = "Command executed successfully"
-
-			// 检查阈值
-			if threshold, ok := item.Params["threshold"].(string); ok {
-				// 根据阈值判断状态
-				if strings.Contains(result.Value, threshold) {
-					result.Status = model.ResultStatusWarning
-					result.Message = fmt.Sprintf("Output contains threshold string: %s", threshold)
-				}
+			exitCode = -1
+			if exitErr, ok := err.(*ssh.ExitError); ok {
+				exitCode = exitErr.ExitStatus()
 			}
 		}
+		applyCommandResult(&result, item, stdout.String(), stderr.String(), exitCode, err)
 	}
 
 	// 设置详细信息
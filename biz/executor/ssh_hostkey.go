@@ -0,0 +1,207 @@
+package executor
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+const (
+	// HostKeyPolicyKnownHosts 只接受 known_hosts 文件中记录的主机密钥
+	HostKeyPolicyKnownHosts = "known_hosts"
+	// HostKeyPolicyTOFU 首次连接时记录主机密钥，之后必须一致
+	HostKeyPolicyTOFU = "tofu"
+	// HostKeyPolicyInsecure 不校验主机密钥，只用于开发环境
+	HostKeyPolicyInsecure = "insecure"
+)
+
+// defaultKnownHostsFile 未配置时使用的 known_hosts 文件
+const defaultKnownHostsFile = "data/ssh/known_hosts"
+
+// hostKeyError 主机密钥校验失败，Want 为空表示没有该主机的记录，否则为密钥不一致
+type hostKeyError struct {
+	Host   string
+	Got    string   // 服务端提供的密钥指纹
+	Want   []string // 期望的密钥指纹
+	Source string   // 期望指纹的来源
+}
+
+// Error 错误信息
+func (e *hostKeyError) Error() string {
+	if len(e.Want) == 0 {
+		return fmt.Sprintf("主机 %s 的密钥 %s 不在 %s 中", e.Host, e.Got, e.Source)
+	}
+	return fmt.Sprintf("主机 %s 的密钥与 %s 不一致: 期望 %s，实际 %s", e.Host, e.Source, strings.Join(e.Want, ", "), e.Got)
+}
+
+// hostKeyVerifier 按配置的策略校验 SSH 主机密钥
+type hostKeyVerifier struct {
+	policy         string
+	knownHostsFile string
+	mu             sync.Mutex // TOFU 模式下串行化检查和记录，避免并发连接同一新主机时重复写入
+}
+
+// newHostKeyVerifier 创建主机密钥校验器。策略为空时只接受 known_hosts 中记录的密钥，
+// 漏配策略时拒绝未知主机，不会在首次连接时记录可能被冒充的密钥
+func newHostKeyVerifier(policy, knownHostsFile string) *hostKeyVerifier {
+	if policy == "" {
+		policy = HostKeyPolicyKnownHosts
+	}
+	if knownHostsFile == "" {
+		knownHostsFile = defaultKnownHostsFile
+	}
+	return &hostKeyVerifier{policy: policy, knownHostsFile: knownHostsFile}
+}
+
+// clientOptions 返回连接 address 时使用的主机密钥回调和主机密钥算法。
+// 任务项指定了 host_key_fingerprint 时只接受这些指纹，否则按配置的策略校验
+func (v *hostKeyVerifier) clientOptions(item model.TaskItem, address string) (ssh.HostKeyCallback, []string, error) {
+	if pins := splitExpected(paramString(item, "host_key_fingerprint")); len(pins) > 0 {
+		for i, pin := range pins {
+			if !strings.HasPrefix(pin, "SHA256:") {
+				pins[i] = "SHA256:" + pin
+			}
+		}
+		return pinnedHostKeyCallback(pins), nil, nil
+	}
+
+	switch v.policy {
+	case HostKeyPolicyInsecure:
+		return ssh.InsecureIgnoreHostKey(), nil, nil
+	case HostKeyPolicyKnownHosts:
+		callback, err := knownhosts.New(v.knownHostsFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("读取 known_hosts 文件失败: %v", err)
+		}
+		return v.wrapKnownHosts(callback), knownHostKeyAlgorithms(callback, address), nil
+	case HostKeyPolicyTOFU:
+		if err := ensureFile(v.knownHostsFile); err != nil {
+			return nil, nil, fmt.Errorf("创建 known_hosts 文件失败: %v", err)
+		}
+		callback, err := knownhosts.New(v.knownHostsFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("读取 known_hosts 文件失败: %v", err)
+		}
+		return v.trustOnFirstUse, knownHostKeyAlgorithms(callback, address), nil
+	default:
+		return nil, nil, fmt.Errorf("不支持的主机密钥校验策略: %s", v.policy)
+	}
+}
+
+// wrapKnownHosts 将 known_hosts 校验失败转换为 hostKeyError
+func (v *hostKeyVerifier) wrapKnownHosts(callback ssh.HostKeyCallback) ssh.HostKeyCallback {
+	return func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+		err := callback(hostname, remote, key)
+		var keyErr *knownhosts.KeyError
+		if errors.As(err, &keyErr) {
+			hkErr := &hostKeyError{
+				Host:   hostname,
+				Got:    ssh.FingerprintSHA256(key),
+				Source: "known_hosts 文件 " + v.knownHostsFile,
+			}
+			for _, want := range keyErr.Want {
+				hkErr.Want = append(hkErr.Want, ssh.FingerprintSHA256(want.Key))
+			}
+			return hkErr
+		}
+		return err
+	}
+}
+
+// trustOnFirstUse 没有该主机的记录时记录服务端提供的密钥并接受连接，有记录时必须一致
+func (v *hostKeyVerifier) trustOnFirstUse(hostname string, remote net.Addr, key ssh.PublicKey) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	// 每次重新读取文件，包含其他连接刚记录的密钥
+	callback, err := knownhosts.New(v.knownHostsFile)
+	if err != nil {
+		return fmt.Errorf("读取 known_hosts 文件失败: %v", err)
+	}
+	err = v.wrapKnownHosts(callback)(hostname, remote, key)
+	var hkErr *hostKeyError
+	if !errors.As(err, &hkErr) || len(hkErr.Want) > 0 {
+		return err
+	}
+
+	f, err := os.OpenFile(v.knownHostsFile, os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("记录主机密钥失败: %v", err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(knownhosts.Line([]string{hostname}, key) + "\n"); err != nil {
+		return fmt.Errorf("记录主机密钥失败: %v", err)
+	}
+	return nil
+}
+
+// pinnedHostKeyCallback 只接受指定指纹的主机密钥
+func pinnedHostKeyCallback(pins []string) ssh.HostKeyCallback {
+	return func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+		got := ssh.FingerprintSHA256(key)
+		for _, pin := range pins {
+			if pin == got {
+				return nil
+			}
+		}
+		return &hostKeyError{Host: hostname, Got: got, Want: pins, Source: "任务项指定的指纹"}
+	}
+}
+
+var (
+	probeKey     ssh.PublicKey
+	probeKeyOnce sync.Once
+)
+
+// knownHostKeyAlgorithms 返回 known_hosts 中该主机已记录密钥对应的算法，
+// 让服务端优先使用已记录的密钥类型，避免因协商到其他类型的密钥被误判为不一致。
+// 没有记录时返回 nil，使用默认算法
+func knownHostKeyAlgorithms(callback ssh.HostKeyCallback, address string) []string {
+	probeKeyOnce.Do(func() {
+		pub, _, err := ed25519.GenerateKey(nil)
+		if err == nil {
+			probeKey, _ = ssh.NewPublicKey(pub)
+		}
+	})
+	if probeKey == nil {
+		return nil
+	}
+
+	// 用一个不可能被记录的密钥校验，从不一致的错误中取出已记录的密钥
+	var keyErr *knownhosts.KeyError
+	if err := callback(address, &net.TCPAddr{IP: net.IPv4zero}, probeKey); !errors.As(err, &keyErr) {
+		return nil
+	}
+
+	var algorithms []string
+	for _, want := range keyErr.Want {
+		if want.Key.Type() == ssh.KeyAlgoRSA {
+			algorithms = append(algorithms, ssh.KeyAlgoRSASHA512, ssh.KeyAlgoRSASHA256, ssh.KeyAlgoRSA)
+		} else {
+			algorithms = append(algorithms, want.Key.Type())
+		}
+	}
+	return algorithms
+}
+
+// ensureFile 文件不存在时创建空文件
+func ensureFile(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
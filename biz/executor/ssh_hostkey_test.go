@@ -0,0 +1,318 @@
+package executor
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+	"github.mokaz111.com/candy-agent/conf"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// testHostKey 生成 ed25519 主机密钥
+func testHostKey(t *testing.T) ssh.Signer {
+	t.Helper()
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := ssh.NewSignerFromKey(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signer
+}
+
+// writeKnownHosts 写入 address 的 known_hosts 记录，每个密钥一行
+func writeKnownHosts(t *testing.T, address string, keys ...ssh.PublicKey) string {
+	t.Helper()
+	var lines strings.Builder
+	for _, key := range keys {
+		lines.WriteString(knownhosts.Line([]string{address}, key) + "\n")
+	}
+	path := filepath.Join(t.TempDir(), "known_hosts")
+	if err := os.WriteFile(path, []byte(lines.String()), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// startSSHServer 启动使用 hostKey 的 SSH 服务，接受任意密码，所有命令输出 ok 并以0退出
+func startSSHServer(t *testing.T, hostKey ssh.Signer) string {
+	t.Helper()
+	config := &ssh.ServerConfig{
+		PasswordCallback: func(ssh.ConnMetadata, []byte) (*ssh.Permissions, error) { return nil, nil },
+	}
+	config.AddHostKey(hostKey)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveSSHConn(conn, config)
+		}
+	}()
+	return listener.Addr().String()
+}
+
+// serveSSHConn 处理一个 SSH 连接
+func serveSSHConn(conn net.Conn, config *ssh.ServerConfig) {
+	defer conn.Close()
+	serverConn, chans, reqs, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		return
+	}
+	defer serverConn.Close()
+	go ssh.DiscardRequests(reqs)
+	for newChannel := range chans {
+		if newChannel.ChannelType() != "session" {
+			newChannel.Reject(ssh.UnknownChannelType, "unsupported")
+			continue
+		}
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer channel.Close()
+			for req := range requests {
+				if req.Type != "exec" {
+					req.Reply(false, nil)
+					continue
+				}
+				req.Reply(true, nil)
+				channel.Write([]byte("ok\n"))
+				channel.SendRequest("exit-status", false, ssh.Marshal(struct{ Status uint32 }{0}))
+				return
+			}
+		}()
+	}
+}
+
+// sshItem 使用密码登录 address 的任务项
+func sshItem(address string, params map[string]interface{}) model.TaskItem {
+	host, port, _ := net.SplitHostPort(address)
+	item := model.TaskItem{ID: 1, Type: "ssh", Params: map[string]interface{}{
+		"host": host, "port": port, "username": "ops", "password": "x", "command": "uptime",
+	}}
+	for k, v := range params {
+		item.Params[k] = v
+	}
+	return item
+}
+
+// checkHostKey 用 clientOptions 返回的回调校验 key
+func checkHostKey(t *testing.T, v *hostKeyVerifier, item model.TaskItem, address string, key ssh.PublicKey) error {
+	t.Helper()
+	callback, _, err := v.clientOptions(item, address)
+	if err != nil {
+		t.Fatalf("clientOptions() error = %v", err)
+	}
+	return callback(address, &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)}, key)
+}
+
+// wantHostKeyError 检查 err 是否为指定指纹的 hostKeyError，want 为空表示主机没有记录
+func wantHostKeyError(t *testing.T, err error, got ssh.PublicKey, want ...ssh.PublicKey) {
+	t.Helper()
+	var hkErr *hostKeyError
+	if !errors.As(err, &hkErr) {
+		t.Fatalf("error = %v, want hostKeyError", err)
+	}
+	if hkErr.Got != ssh.FingerprintSHA256(got) {
+		t.Errorf("got fingerprint = %s, want %s", hkErr.Got, ssh.FingerprintSHA256(got))
+	}
+	var wantFingerprints []string
+	for _, key := range want {
+		wantFingerprints = append(wantFingerprints, ssh.FingerprintSHA256(key))
+	}
+	if !reflect.DeepEqual(hkErr.Want, wantFingerprints) {
+		t.Errorf("want fingerprints = %v, want %v", hkErr.Want, wantFingerprints)
+	}
+}
+
+func TestHostKeyVerifierDefaultPolicy(t *testing.T) {
+	v := newHostKeyVerifier("", "")
+	if v.policy != HostKeyPolicyKnownHosts || v.knownHostsFile != defaultKnownHostsFile {
+		t.Fatalf("verifier = %s %s, want known_hosts %s", v.policy, v.knownHostsFile, defaultKnownHostsFile)
+	}
+
+	// 漏配策略且没有 known_hosts 文件时拒绝连接，不会记录密钥
+	path := filepath.Join(t.TempDir(), "known_hosts")
+	v = newHostKeyVerifier("", path)
+	if _, _, err := v.clientOptions(model.TaskItem{}, "10.0.0.1:22"); err == nil || !strings.Contains(err.Error(), "读取 known_hosts 文件失败") {
+		t.Fatalf("clientOptions() error = %v, want missing known_hosts error", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("known_hosts file was created: %v", err)
+	}
+}
+
+func TestHostKeyVerifierKnownHosts(t *testing.T) {
+	const address = "10.0.0.1:2222"
+	recorded := testHostKey(t).PublicKey()
+	other := testHostKey(t).PublicKey()
+	v := newHostKeyVerifier(HostKeyPolicyKnownHosts, writeKnownHosts(t, address, recorded))
+
+	if err := checkHostKey(t, v, model.TaskItem{}, address, recorded); err != nil {
+		t.Errorf("recorded key error = %v", err)
+	}
+	wantHostKeyError(t, checkHostKey(t, v, model.TaskItem{}, address, other), other, recorded)
+	wantHostKeyError(t, checkHostKey(t, v, model.TaskItem{}, "10.0.0.2:2222", recorded), recorded)
+}
+
+func TestHostKeyVerifierPinned(t *testing.T) {
+	const address = "10.0.0.1:22"
+	pinned := testHostKey(t).PublicKey()
+	other := testHostKey(t).PublicKey()
+	// 配置的策略不接受任何主机，指定指纹时不使用配置的策略
+	v := newHostKeyVerifier(HostKeyPolicyKnownHosts, filepath.Join(t.TempDir(), "missing"))
+
+	tests := []struct {
+		name string
+		pin  string
+	}{
+		{name: "with prefix", pin: ssh.FingerprintSHA256(pinned)},
+		{name: "without prefix", pin: strings.TrimPrefix(ssh.FingerprintSHA256(pinned), "SHA256:")},
+		{name: "one of several", pin: ssh.FingerprintSHA256(testHostKey(t).PublicKey()) + ", " + ssh.FingerprintSHA256(pinned)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := model.TaskItem{Params: map[string]interface{}{"host_key_fingerprint": tt.pin}}
+			if err := checkHostKey(t, v, item, address, pinned); err != nil {
+				t.Errorf("pinned key error = %v", err)
+			}
+			err := checkHostKey(t, v, item, address, other)
+			var hkErr *hostKeyError
+			if !errors.As(err, &hkErr) || len(hkErr.Want) == 0 || hkErr.Source != "任务项指定的指纹" {
+				t.Errorf("other key error = %v, want pinned mismatch", err)
+			}
+		})
+	}
+}
+
+func TestHostKeyVerifierTOFU(t *testing.T) {
+	const address = "10.0.0.1:22"
+	first := testHostKey(t).PublicKey()
+	changed := testHostKey(t).PublicKey()
+	path := filepath.Join(t.TempDir(), "ssh", "known_hosts")
+	v := newHostKeyVerifier(HostKeyPolicyTOFU, path)
+
+	if err := checkHostKey(t, v, model.TaskItem{}, address, first); err != nil {
+		t.Fatalf("first use error = %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := knownhosts.Line([]string{address}, first) + "\n"; string(data) != want {
+		t.Fatalf("known_hosts = %q, want %q", data, want)
+	}
+
+	if err := checkHostKey(t, v, model.TaskItem{}, address, first); err != nil {
+		t.Errorf("same key error = %v", err)
+	}
+	wantHostKeyError(t, checkHostKey(t, v, model.TaskItem{}, address, changed), changed, first)
+
+	// 已记录的主机密钥变化后不会追加新记录
+	if after, _ := os.ReadFile(path); string(after) != string(data) {
+		t.Errorf("known_hosts changed after mismatch: %q", after)
+	}
+}
+
+func TestKnownHostKeyAlgorithms(t *testing.T) {
+	const address = "10.0.0.1:22"
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaPub, _ := ssh.NewPublicKey(&rsaKey.PublicKey)
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecdsaPub, _ := ssh.NewPublicKey(&ecdsaKey.PublicKey)
+
+	callback, err := knownhosts.New(writeKnownHosts(t, address, ecdsaPub, rsaPub))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{ssh.KeyAlgoECDSA256, ssh.KeyAlgoRSASHA512, ssh.KeyAlgoRSASHA256, ssh.KeyAlgoRSA}
+	if got := knownHostKeyAlgorithms(callback, address); !reflect.DeepEqual(got, want) {
+		t.Errorf("knownHostKeyAlgorithms() = %v, want %v", got, want)
+	}
+	if got := knownHostKeyAlgorithms(callback, "10.0.0.2:22"); got != nil {
+		t.Errorf("knownHostKeyAlgorithms() for unknown host = %v, want nil", got)
+	}
+}
+
+func TestSSHExecuteHostKey(t *testing.T) {
+	serverKey := testHostKey(t)
+	address := startSSHServer(t, serverKey)
+	recorded := testHostKey(t).PublicKey()
+
+	t.Run("mismatch is critical and final", func(t *testing.T) {
+		e := NewSSHExecutor(conf.SSHConfig{KnownHostsFile: writeKnownHosts(t, address, recorded)})
+		result, err := e.Execute(context.Background(), sshItem(address, nil))
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if result.Status != model.ResultStatusCritical || !result.Final {
+			t.Fatalf("result = %s final=%v, want critical final", result.Status, result.Final)
+		}
+		for _, fingerprint := range []string{ssh.FingerprintSHA256(recorded), ssh.FingerprintSHA256(serverKey.PublicKey())} {
+			if !strings.Contains(result.Message, fingerprint) || !strings.Contains(result.Details, fingerprint) {
+				t.Errorf("result does not contain %s:\n%s\n%s", fingerprint, result.Message, result.Details)
+			}
+		}
+	})
+
+	t.Run("unknown host fails without retry", func(t *testing.T) {
+		e := NewSSHExecutor(conf.SSHConfig{KnownHostsFile: writeKnownHosts(t, "10.0.0.1:22", recorded)})
+		result, err := e.Execute(context.Background(), sshItem(address, nil))
+		if err == nil || result.Status != model.ResultStatusFailed || !result.Final {
+			t.Fatalf("result = %s final=%v error=%v, want failed final", result.Status, result.Final, err)
+		}
+		if !strings.Contains(result.Message, ssh.FingerprintSHA256(serverKey.PublicKey())) {
+			t.Errorf("message = %q, want server fingerprint", result.Message)
+		}
+	})
+
+	t.Run("tofu records and runs", func(t *testing.T) {
+		e := NewSSHExecutor(conf.SSHConfig{HostKeyPolicy: HostKeyPolicyTOFU, KnownHostsFile: filepath.Join(t.TempDir(), "known_hosts")})
+		for i := 0; i < 2; i++ {
+			result, err := e.Execute(context.Background(), sshItem(address, nil))
+			if err != nil || result.Status != model.ResultStatusNormal || result.Value != "ok\n" {
+				t.Fatalf("run %d result = %s %q error=%v, want normal ok", i, result.Status, result.Value, err)
+			}
+		}
+	})
+
+	t.Run("pinned fingerprint overrides policy", func(t *testing.T) {
+		e := NewSSHExecutor(conf.SSHConfig{KnownHostsFile: writeKnownHosts(t, address, recorded)})
+		item := sshItem(address, map[string]interface{}{"host_key_fingerprint": ssh.FingerprintSHA256(serverKey.PublicKey())})
+		result, err := e.Execute(context.Background(), item)
+		if err != nil || result.Status != model.ResultStatusNormal {
+			t.Fatalf("result = %s %q error=%v, want normal", result.Status, result.Message, err)
+		}
+	})
+}
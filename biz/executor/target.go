@@ -0,0 +1,17 @@
+package executor
+
+import "github.mokaz111.com/candy-agent/biz/model"
+
+// Targeter 可以识别任务项目标的执行器，按目标限流时使用
+type Targeter interface {
+	// Target 返回任务项访问的目标，如主机地址或监控后端地址
+	Target(item model.TaskItem) string
+}
+
+// TargetOf 返回任务项的目标，执行器未实现 Targeter 时为空
+func TargetOf(executor Executor, item model.TaskItem) string {
+	if targeter, ok := executor.(Targeter); ok {
+		return targeter.Target(item)
+	}
+	return ""
+}
@@ -0,0 +1,182 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+	"github.mokaz111.com/candy-agent/conf"
+)
+
+// maxBannerSize 读取服务端 banner 的最大字节数
+const maxBannerSize = 4096
+
+// TCPExecutor TCP 端口探测执行器
+type TCPExecutor struct {
+	config conf.TCPConfig
+}
+
+// NewTCPExecutor 创建 TCP 执行器
+func NewTCPExecutor(config conf.TCPConfig) *TCPExecutor {
+	return &TCPExecutor{config: config}
+}
+
+// Name 执行器名称
+func (e *TCPExecutor) Name() string {
+	return "tcp"
+}
+
+// Schema 执行器参数说明
+func (e *TCPExecutor) Schema() Schema {
+	return Schema{
+		Name:        e.Name(),
+		Description: "检查 TCP 端口是否可以连接，可选检查服务端返回的 banner",
+		Params: []ParamSchema{
+			{Name: "host", Type: ParamTypeString, Required: true, Description: "主机地址"},
+			{Name: "port", Type: ParamTypeInteger, Required: true, Description: "端口"},
+			{Name: "send", Type: ParamTypeString, Description: "连接后发送的数据，支持 \\r \\n 转义"},
+			{Name: "banner_regex", Type: ParamTypeRegex, Description: "服务端返回内容需要匹配的正则表达式，设置后读取返回内容"},
+			{Name: "latency_warning", Type: ParamTypeInteger, Description: "连接延迟超过该值(毫秒)时为警告"},
+			{Name: "latency_critical", Type: ParamTypeInteger, Description: "连接延迟超过该值(毫秒)时为严重"},
+		},
+	}
+}
+
+// Target 返回任务项的地址 host:port
+func (e *TCPExecutor) Target(item model.TaskItem) string {
+	return net.JoinHostPort(paramString(item, "host"), paramString(item, "port"))
+}
+
+// Probe 检查端口是否可以建立 TCP 连接
+func (e *TCPExecutor) Probe(ctx context.Context, item model.TaskItem) error {
+	address := e.Target(item)
+	dialer := net.Dialer{Timeout: itemTimeout(item, e.config.Timeout, 10)}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return fmt.Errorf("连接 %s 失败: %v", address, err)
+	}
+	return conn.Close()
+}
+
+// Execute 执行巡检项
+func (e *TCPExecutor) Execute(ctx context.Context, item model.TaskItem) (model.TaskResult, error) {
+	startTime := time.Now()
+	result := model.TaskResult{
+		ItemID: item.ID,
+		Status: model.ResultStatusNormal,
+	}
+
+	address := e.Target(item)
+	connCtx, cancel := context.WithTimeout(ctx, itemTimeout(item, e.config.Timeout, 10))
+	defer cancel()
+
+	// 建立连接
+	dialer := net.Dialer{}
+	conn, err := dialer.DialContext(connCtx, "tcp", address)
+	latency := time.Since(startTime).Milliseconds()
+	if err != nil {
+		result.Status = model.ResultStatusFailed
+		result.Message = fmt.Sprintf("连接 %s 失败: %v", address, err)
+		result.Duration = time.Since(startTime).Milliseconds()
+		return result, err
+	}
+	defer conn.Close()
+
+	// 任务取消或超时时关闭连接，中断读写
+	stop := context.AfterFunc(connCtx, func() { conn.Close() })
+	defer stop()
+
+	result.Value = strconv.FormatInt(latency, 10)
+	result.Labels = map[string]string{"address": address}
+
+	var critical, warnings []string
+	var banner string
+	if pattern := paramString(item, "banner_regex"); pattern != "" {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			result.Status = model.ResultStatusFailed
+			result.Message = fmt.Sprintf("banner_regex 无效: %v", err)
+			result.Duration = time.Since(startTime).Milliseconds()
+			return result, err
+		}
+
+		banner, err = readBanner(connCtx, conn, paramString(item, "send"), re)
+		if !re.MatchString(banner) {
+			// 任务被取消时为执行失败，任务项超时仍未读到匹配内容时为巡检发现的问题
+			if ctx.Err() != nil {
+				result.Status = model.ResultStatusFailed
+				result.Message = fmt.Sprintf("读取 %s 返回内容时中止: %v", address, ctx.Err())
+				result.Duration = time.Since(startTime).Milliseconds()
+				return result, ctx.Err()
+			}
+			msg := fmt.Sprintf("返回内容不匹配 %s", pattern)
+			if connCtx.Err() != nil {
+				msg += ": 读取超时"
+			} else if err != nil {
+				msg = fmt.Sprintf("%s: %v", msg, err)
+			}
+			critical = append(critical, msg)
+		}
+	}
+
+	if limit, ok := paramFloat(item, "latency_critical"); ok && limit > 0 && float64(latency) > limit {
+		critical = append(critical, fmt.Sprintf("连接延迟 %dms 超过 %.0fms", latency, limit))
+	} else if limit, ok := paramFloat(item, "latency_warning"); ok && limit > 0 && float64(latency) > limit {
+		warnings = append(warnings, fmt.Sprintf("连接延迟 %dms 超过 %.0fms", latency, limit))
+	}
+
+	switch {
+	case len(critical) > 0:
+		result.Status = model.ResultStatusCritical
+		result.Message = strings.Join(append(critical, warnings...), "; ")
+	case len(warnings) > 0:
+		result.Status = model.ResultStatusWarning
+		result.Message = strings.Join(warnings, "; ")
+	default:
+		result.Message = fmt.Sprintf("连接 %s 成功，延迟 %dms", address, latency)
+	}
+
+	var details strings.Builder
+	details.WriteString(fmt.Sprintf("Address: %s\n", address))
+	details.WriteString(fmt.Sprintf("Connect Latency: %dms\n", latency))
+	if banner != "" {
+		details.WriteString(fmt.Sprintf("Banner: %s\n", truncateString(banner, 1024)))
+	}
+	result.Details = details.String()
+	result.Duration = time.Since(startTime).Milliseconds()
+
+	return result, nil
+}
+
+// readBanner 发送数据后读取服务端返回内容，直到匹配正则、连接关闭、读满上限或上下文结束
+func readBanner(ctx context.Context, conn net.Conn, send string, re *regexp.Regexp) (string, error) {
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	if send != "" {
+		send = strings.NewReplacer(`\r`, "\r", `\n`, "\n").Replace(send)
+		if _, err := conn.Write([]byte(send)); err != nil {
+			return "", fmt.Errorf("发送数据失败: %v", err)
+		}
+	}
+
+	buf := make([]byte, 0, maxBannerSize)
+	chunk := make([]byte, 512)
+	for len(buf) < maxBannerSize {
+		n, err := conn.Read(chunk)
+		buf = append(buf, chunk[:n]...)
+		if re.Match(buf) {
+			return string(buf), nil
+		}
+		if err != nil {
+			return string(buf), err
+		}
+	}
+	return string(buf), nil
+}
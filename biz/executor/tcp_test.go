@@ -0,0 +1,152 @@
+package executor
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+	"github.mokaz111.com/candy-agent/conf"
+)
+
+// startTCPServer 在本地端口启动 TCP 服务，每个连接交给 handle 处理，返回任务项的 host 和 port 参数
+func startTCPServer(t *testing.T, handle func(conn net.Conn)) map[string]interface{} {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				handle(conn)
+			}()
+		}
+	}()
+	addr := listener.Addr().(*net.TCPAddr)
+	return map[string]interface{}{"host": "127.0.0.1", "port": strconv.Itoa(addr.Port)}
+}
+
+// silentHandler 保持连接但从不发送数据，直到客户端关闭连接
+func silentHandler(conn net.Conn) {
+	buf := make([]byte, 64)
+	for {
+		if _, err := conn.Read(buf); err != nil {
+			return
+		}
+	}
+}
+
+// pingHandler 收到 PING 后返回 reply
+func pingHandler(reply string) func(conn net.Conn) {
+	return func(conn net.Conn) {
+		line, err := bufio.NewReader(conn).ReadString('\n')
+		if err != nil || strings.TrimSpace(line) != "PING" {
+			return
+		}
+		conn.Write([]byte(reply))
+	}
+}
+
+func TestTCPExecute(t *testing.T) {
+	tests := []struct {
+		name        string
+		handle      func(conn net.Conn)
+		params      map[string]interface{}
+		wantStatus  model.ResultStatus
+		wantMessage string
+	}{
+		{name: "connect only", handle: silentHandler, wantStatus: model.ResultStatusNormal, wantMessage: "成功"},
+		{
+			name:       "banner matches",
+			handle:     pingHandler("+PONG\r\n"),
+			params:     map[string]interface{}{"send": `PING\r\n`, "banner_regex": `^\+PONG`},
+			wantStatus: model.ResultStatusNormal, wantMessage: "成功",
+		},
+		{
+			name:       "banner mismatch before close",
+			handle:     pingHandler("-ERR unknown\r\n"),
+			params:     map[string]interface{}{"send": `PING\r\n`, "banner_regex": `^\+PONG`},
+			wantStatus: model.ResultStatusCritical, wantMessage: "返回内容不匹配 ^\\+PONG: EOF",
+		},
+		{
+			name:       "server never sends",
+			handle:     silentHandler,
+			params:     map[string]interface{}{"banner_regex": "^SSH-"},
+			wantStatus: model.ResultStatusCritical, wantMessage: "读取超时",
+		},
+	}
+	e := NewTCPExecutor(conf.TCPConfig{})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := startTCPServer(t, tt.handle)
+			for k, v := range tt.params {
+				params[k] = v
+			}
+			result, err := e.Execute(context.Background(), model.TaskItem{ID: 1, Type: "tcp", TimeoutSeconds: 1, Params: params})
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if result.Status != tt.wantStatus || !strings.Contains(result.Message, tt.wantMessage) {
+				t.Errorf("result = %s %q, want %s %q", result.Status, result.Message, tt.wantStatus, tt.wantMessage)
+			}
+			if result.Labels["address"] != net.JoinHostPort("127.0.0.1", params["port"].(string)) {
+				t.Errorf("address label = %q", result.Labels["address"])
+			}
+		})
+	}
+}
+
+func TestTCPExecuteCanceled(t *testing.T) {
+	params := startTCPServer(t, silentHandler)
+	params["banner_regex"] = "^SSH-"
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	start := time.Now()
+	result, err := NewTCPExecutor(conf.TCPConfig{}).Execute(ctx, model.TaskItem{ID: 1, Type: "tcp", TimeoutSeconds: 30, Params: params})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Execute() error = %v, want context.Canceled", err)
+	}
+	if result.Status != model.ResultStatusFailed || !strings.Contains(result.Message, "中止") {
+		t.Errorf("result = %s %q, want failed", result.Status, result.Message)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Execute() returned after %v, want prompt return on cancel", elapsed)
+	}
+}
+
+func TestTCPExecuteConnectFailed(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+	port := strconv.Itoa(listener.Addr().(*net.TCPAddr).Port)
+	listener.Close()
+
+	result, err := NewTCPExecutor(conf.TCPConfig{}).Execute(context.Background(), model.TaskItem{ID: 1, Type: "tcp", TimeoutSeconds: 1,
+		Params: map[string]interface{}{"host": "127.0.0.1", "port": port}})
+	if err == nil || result.Status != model.ResultStatusFailed {
+		t.Fatalf("Execute() = %s, %v, want failed with error", result.Status, err)
+	}
+}
+
+func TestTCPSchemaRejectsInvalidBannerRegex(t *testing.T) {
+	schema := NewTCPExecutor(conf.TCPConfig{}).Schema()
+	errs := schema.Validate(map[string]interface{}{"host": "127.0.0.1", "port": "22", "banner_regex": "(SSH"})
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "banner_regex 不是有效的正则表达式") {
+		t.Fatalf("Validate() errors = %v, want invalid banner_regex", errs)
+	}
+}
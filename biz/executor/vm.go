@@ -53,14 +53,8 @@ func NewVMExecutor(config config.VMConfig) (*VMExecutor, error) {
 		return nil, fmt.Errorf("VictoriaMetrics URL is required")
 	}
 
-	timeout := config.Timeout
-	if timeout <= 0 {
-		timeout = 30
-	}
-
-	client := &http.Client{
-		Timeout: time.Duration(timeout) * time.Second,
-	}
+	// 超时由每次查询的上下文控制，任务项可以单独设置超时
+	client := &http.Client{}
 
 	return &VMExecutor{
 		client:  client,
@@ -74,6 +68,33 @@ func (e *VMExecutor) Name() string {
 	return "victoriaMetrics"
 }
 
+// Target 返回 VictoriaMetrics 地址，所有任务项共用同一个后端
+func (e *VMExecutor) Target(item model.TaskItem) string {
+	return e.baseURL
+}
+
+// Schema 执行器参数说明
+func (e *VMExecutor) Schema() Schema {
+	return Schema{
+		Name:        e.Name(),
+		Description: "通过 VictoriaMetrics 执行 PromQL/MetricsQL 即时查询，并按阈值判断结果",
+		Params: []ParamSchema{
+			{Name: "query", Type: ParamTypeString, Required: true, Description: "PromQL/MetricsQL 查询语句"},
+			{Name: "threshold", Type: ParamTypeNumber, Description: "告警阈值，查询结果大于阈值时为警告"},
+		},
+	}
+}
+
+// Probe 检查 VictoriaMetrics 是否可达
+func (e *VMExecutor) Probe(ctx context.Context, item model.TaskItem) error {
+	probeCtx, cancel := context.WithTimeout(ctx, itemTimeout(item, e.config.Timeout, 10))
+	defer cancel()
+
+	header := http.Header{}
+	header.Set("Authorization", "Bearer "+e.config.Token)
+	return probeHTTP(probeCtx, e.client, e.baseURL+"/health", header)
+}
+
 // Execute 执行巡检项
 func (e *VMExecutor) Execute(ctx context.Context, item model.TaskItem) (model.TaskResult, error) {
 	startTime := time.Now()
@@ -92,11 +113,7 @@ func (e *VMExecutor) Execute(ctx context.Context, item model.TaskItem) (model.Ta
 	}
 
 	// 设置超时上下文
-	timeout := e.config.Timeout
-	if timeout <= 0 {
-		timeout = 30
-	}
-	ctxWithTimeout, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, itemTimeout(item, e.config.Timeout, 30))
 	defer cancel()
 
 	// 构建查询 URL
@@ -165,6 +182,7 @@ func (e *VMExecutor) Execute(ctx context.Context, item model.TaskItem) (model.Ta
 
 	// 设置结果值
 	result.Value = parsedValue
+	result.Labels = vmResultLabels(vmResp.Data.ResultType, vmResp.Data.Result)
 
 	// 检查阈值
 	if thresholdStr, ok := item.Params["threshold"].(string); ok {
@@ -223,6 +241,21 @@ func (e *VMExecutor) Execute(ctx context.Context, item model.TaskItem) (model.Ta
 	return result, nil
 }
 
+// vmResultLabels 返回结果值对应样本的标签，即第一个样本或时间序列的标签
+func vmResultLabels(resultType string, resultData json.RawMessage) map[string]string {
+	if resultType != "vector" && resultType != "matrix" {
+		return nil
+	}
+
+	var series []struct {
+		Metric map[string]string `json:"metric"`
+	}
+	if err := json.Unmarshal(resultData, &series); err != nil || len(series) == 0 || len(series[0].Metric) == 0 {
+		return nil
+	}
+	return series[0].Metric
+}
+
 // parseVMResult 解析 VictoriaMetrics 结果
 func (e *VMExecutor) parseVMResult(resultType string, resultData json.RawMessage) (string, map[string]string, error) {
 	allValues := make(map[string]string)
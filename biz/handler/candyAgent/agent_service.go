@@ -2,12 +2,12 @@ package candyAgent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
-	dal "github.mokaz111.com/candy-agent/biz/dal/executor"
 	"github.mokaz111.com/candy-agent/biz/service"
 	"github.mokaz111.com/candy-agent/biz/utils"
 	candyAgent "github.mokaz111.com/candy-agent/hertz_gen/candyAgent"
@@ -33,26 +33,35 @@ func ExecuteTask(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	if len(req.Items) == 0 {
+	if len(req.Items) == 0 && req.Template == "" {
 		hlog.Errorf("No task items in request")
-		utils.SendErrResponse(ctx, c, consts.StatusOK, fmt.Errorf("at least one task item is required"))
+		utils.SendErrResponse(ctx, c, consts.StatusOK, fmt.Errorf("at least one task item or a template is required"))
 		return
 	}
 
-	// 设置默认超时
-	if req.Timeout <= 0 {
-		req.Timeout = 30 // 默认30秒
+	// 请求体未指定幂等键时使用 Idempotency-Key 请求头
+	if req.IdempotencyKey == "" {
+		req.IdempotencyKey = string(c.GetHeader("Idempotency-Key"))
 	}
 
-	e := dal.ExecutorFactory
-	// 同步执行任务
-	resp, err := service.NewExecuteTaskService(ctx, c, e).Run(&req)
+	// 通过任务管理器执行任务，mode 决定同步等待还是立即返回
+	resp, err := service.NewExecuteTaskService(ctx, c).Run(&req)
 	if err != nil {
 		hlog.Errorf("Failed to execute task: %v", err)
-		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		// 参数校验失败时按任务项返回错误明细
+		var paramErr *service.TaskParamError
+		if errors.As(err, &paramErr) {
+			utils.SendCustomResponse(ctx, c, consts.StatusOK, consts.StatusBadRequest, err.Error(), paramErr)
+			return
+		}
+		code := consts.StatusOK
+		if errors.Is(err, service.ErrIdempotencyConflict) {
+			code = consts.StatusConflict
+		}
+		utils.SendErrResponse(ctx, c, code, err)
 		return
 	}
-	hlog.Infof("Successfully executed task %s with %d results", req.TaskId, len(resp.Results))
+	hlog.Infof("Task %s accepted with status %s and %d results", req.TaskId, resp.Status, len(resp.Results))
 	utils.SendSuccessResponse(ctx, c, consts.StatusOK, resp)
 }
 
@@ -165,6 +174,340 @@ func CancelTask(ctx context.Context, c *app.RequestContext) {
 	utils.SendSuccessResponse(ctx, c, consts.StatusOK, resp)
 }
 
+// GetTaskResult .
+// @router /api/v1/results/:task_id [GET]
+func GetTaskResult(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req candyAgent.TaskResultRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewGetTaskResultService(ctx, c).Run(&req)
+
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+	utils.SendSuccessResponse(ctx, c, consts.StatusOK, resp)
+}
+
+// ListCallbacks .
+// @router /api/v1/callbacks [GET]
+func ListCallbacks(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req candyAgent.Empty
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewListCallbacksService(ctx, c).Run(&req)
+
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+	utils.SendSuccessResponse(ctx, c, consts.StatusOK, resp)
+}
+
+// RedeliverCallback .
+// @router /api/v1/callbacks/:task_id/redeliver [POST]
+func RedeliverCallback(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req candyAgent.CallbackRedeliverRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewRedeliverCallbackService(ctx, c).Run(&req)
+
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+	utils.SendSuccessResponse(ctx, c, consts.StatusOK, resp)
+}
+
+// CreateSchedule .
+// @router /api/v1/schedules [POST]
+func CreateSchedule(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req candyAgent.ScheduleRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewCreateScheduleService(ctx, c).Run(&req)
+
+	if err != nil {
+		var paramErr *service.TaskParamError
+		if errors.As(err, &paramErr) {
+			utils.SendCustomResponse(ctx, c, consts.StatusOK, consts.StatusBadRequest, err.Error(), paramErr)
+			return
+		}
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+	utils.SendSuccessResponse(ctx, c, consts.StatusOK, resp)
+}
+
+// ListSchedules .
+// @router /api/v1/schedules [GET]
+func ListSchedules(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req candyAgent.Empty
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewListSchedulesService(ctx, c).Run(&req)
+
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+	utils.SendSuccessResponse(ctx, c, consts.StatusOK, resp)
+}
+
+// GetSchedule .
+// @router /api/v1/schedules/:schedule_id [GET]
+func GetSchedule(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req candyAgent.ScheduleRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewGetScheduleService(ctx, c).Run(&req)
+
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+	utils.SendSuccessResponse(ctx, c, consts.StatusOK, resp)
+}
+
+// UpdateSchedule .
+// @router /api/v1/schedules/:schedule_id [PUT]
+func UpdateSchedule(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req candyAgent.ScheduleRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewUpdateScheduleService(ctx, c).Run(&req)
+
+	if err != nil {
+		var paramErr *service.TaskParamError
+		if errors.As(err, &paramErr) {
+			utils.SendCustomResponse(ctx, c, consts.StatusOK, consts.StatusBadRequest, err.Error(), paramErr)
+			return
+		}
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+	utils.SendSuccessResponse(ctx, c, consts.StatusOK, resp)
+}
+
+// DeleteSchedule .
+// @router /api/v1/schedules/:schedule_id [DELETE]
+func DeleteSchedule(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req candyAgent.ScheduleRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewDeleteScheduleService(ctx, c).Run(&req)
+
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+	utils.SendSuccessResponse(ctx, c, consts.StatusOK, resp)
+}
+
+// StreamTaskEvents .
+// @router /api/v1/tasks/:task_id/events [GET]
+func StreamTaskEvents(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req candyAgent.TaskStatusRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	// 事件通过 Server-Sent Events 直接写入响应，只有开始推送前的错误需要返回
+	_, err = service.NewStreamTaskEventsService(ctx, c).Run(&req)
+
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+}
+
+// ListTasks .
+// @router /api/v1/tasks [GET]
+func ListTasks(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req candyAgent.TaskListRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewListTasksService(ctx, c).Run(&req)
+
+	if err != nil {
+		code := consts.StatusOK
+		if errors.Is(err, service.ErrInvalidTaskQuery) {
+			code = consts.StatusBadRequest
+		}
+		utils.SendErrResponse(ctx, c, code, err)
+		return
+	}
+	utils.SendSuccessResponse(ctx, c, consts.StatusOK, resp)
+}
+
+// CreateTemplate .
+// @router /api/v1/templates [POST]
+func CreateTemplate(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req candyAgent.TemplateRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewCreateTemplateService(ctx, c).Run(&req)
+
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+	utils.SendSuccessResponse(ctx, c, consts.StatusOK, resp)
+}
+
+// ListTemplates .
+// @router /api/v1/templates [GET]
+func ListTemplates(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req candyAgent.Empty
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewListTemplatesService(ctx, c).Run(&req)
+
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+	utils.SendSuccessResponse(ctx, c, consts.StatusOK, resp)
+}
+
+// GetTemplate .
+// @router /api/v1/templates/:name [GET]
+func GetTemplate(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req candyAgent.TemplateRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewGetTemplateService(ctx, c).Run(&req)
+
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+	utils.SendSuccessResponse(ctx, c, consts.StatusOK, resp)
+}
+
+// UpdateTemplate .
+// @router /api/v1/templates/:name [PUT]
+func UpdateTemplate(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req candyAgent.TemplateRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewUpdateTemplateService(ctx, c).Run(&req)
+
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+	utils.SendSuccessResponse(ctx, c, consts.StatusOK, resp)
+}
+
+// DeleteTemplate .
+// @router /api/v1/templates/:name [DELETE]
+func DeleteTemplate(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req candyAgent.TemplateRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewDeleteTemplateService(ctx, c).Run(&req)
+
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+	utils.SendSuccessResponse(ctx, c, consts.StatusOK, resp)
+}
+
+// ListExecutors .
+// @router /api/v1/executors [GET]
+func ListExecutors(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req candyAgent.Empty
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewListExecutorsService(ctx, c).Run(&req)
+
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+	utils.SendSuccessResponse(ctx, c, consts.StatusOK, resp)
+}
+
 // ReadyCheck .
 // @router /ready [GET]
 func ReadyCheck(ctx context.Context, c *app.RequestContext) {
@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+// CallbackState 回调投递状态
+type CallbackState string
+
+const (
+	// CallbackStatePending 等待投递或重试
+	CallbackStatePending CallbackState = "pending"
+	// CallbackStateExhausted 重试次数已用完，需要手动重新投递
+	CallbackStateExhausted CallbackState = "exhausted"
+)
+
+// CallbackRecord 回调发件箱中的待投递记录，同一任务只保留最新的一条
+type CallbackRecord struct {
+	TaskID      string        `json:"task_id"`
+	Payload     TaskCallback  `json:"payload"`
+	State       CallbackState `json:"state"`
+	Attempts    int           `json:"attempts"`     // 已尝试投递次数
+	NextAttempt time.Time     `json:"next_attempt"` // 下次投递时间
+	LastError   string        `json:"last_error,omitempty"`
+	CreatedAt   time.Time     `json:"created_at"`
+	UpdatedAt   time.Time     `json:"updated_at"`
+}
+
+// CallbackStore 回调记录存储接口，用于在 Agent 重启后继续投递未送达的回调
+type CallbackStore = Store[CallbackRecord]
@@ -0,0 +1,50 @@
+package model
+
+import "time"
+
+// ResultTransition 任务项结果相对同一巡检项上一次结果的变化
+type ResultTransition string
+
+const (
+	// TransitionNew 新出现的异常，上一次正常或没有历史结果
+	TransitionNew ResultTransition = "new"
+	// TransitionResolved 异常已恢复
+	TransitionResolved ResultTransition = "resolved"
+	// TransitionPersisting 异常持续，状态与上一次相同
+	TransitionPersisting ResultTransition = "persisting"
+	// TransitionChanged 异常级别变化，或正常状态下结果值变化
+	TransitionChanged ResultTransition = "changed"
+	// TransitionUnchanged 正常且结果值未变化
+	TransitionUnchanged ResultTransition = "unchanged"
+)
+
+// ResultHistoryEntry 巡检项的一次历史结果
+type ResultHistoryEntry struct {
+	TaskID string       `json:"task_id"`
+	Status ResultStatus `json:"status"`
+	Value  string       `json:"value"`
+	Time   time.Time    `json:"time"`
+}
+
+// ResultHistory 同一巡检项最近的执行结果，按时间升序排列
+type ResultHistory struct {
+	Key     string               `json:"key"` // 巡检项标识
+	Type    string               `json:"type"`
+	Name    string               `json:"name"`
+	Entries []ResultHistoryEntry `json:"entries"`
+}
+
+// ResultChange 回调中相对上一次执行发生变化的任务项
+type ResultChange struct {
+	ItemID         uint             `json:"item_id"`
+	Name           string           `json:"name"`
+	Transition     ResultTransition `json:"transition"`
+	Status         ResultStatus     `json:"status"`
+	PreviousStatus ResultStatus     `json:"previous_status,omitempty"`
+	Value          string           `json:"value"`
+	PreviousValue  string           `json:"previous_value,omitempty"`
+	Delta          *float64         `json:"delta,omitempty"`
+}
+
+// ResultHistoryStore 巡检项结果历史存储接口，ID 为巡检项标识
+type ResultHistoryStore = Store[ResultHistory]
@@ -0,0 +1,46 @@
+package model
+
+import "time"
+
+// MissedRunPolicy Agent 停止期间错过执行时间的处理方式
+type MissedRunPolicy string
+
+const (
+	// MissedRunSkip 跳过错过的执行，等待下一次执行时间
+	MissedRunSkip MissedRunPolicy = "skip"
+	// MissedRunOnce Agent 启动后立即补执行一次
+	MissedRunOnce MissedRunPolicy = "run_once"
+)
+
+// OverlapPolicy 到达执行时间时上一次任务仍未结束的处理方式
+type OverlapPolicy string
+
+const (
+	// OverlapSkip 跳过本次执行
+	OverlapSkip OverlapPolicy = "skip"
+	// OverlapAllow 允许同时执行
+	OverlapAllow OverlapPolicy = "allow"
+	// OverlapReplace 取消上一次任务后执行
+	OverlapReplace OverlapPolicy = "replace"
+)
+
+// Schedule 定时巡检，按 cron 表达式定期向任务管理器提交任务
+type Schedule struct {
+	ID              string          `json:"id"`
+	Name            string          `json:"name"`
+	Cron            string          `json:"cron"`                  // 标准5段 cron 表达式，也支持 @every 1h 等描述符
+	Items           []TaskItem      `json:"items"`                 // 每次执行提交的任务项
+	Timeout         int             `json:"timeout"`               // 任务超时时间(秒)
+	Parallelism     int             `json:"parallelism,omitempty"` // 任务项最大并发数
+	Priority        int             `json:"priority,omitempty"`    // 提交任务的优先级
+	Disabled        bool            `json:"disabled,omitempty"`    // 是否暂停
+	MissedRunPolicy MissedRunPolicy `json:"missed_run_policy"`
+	OverlapPolicy   OverlapPolicy   `json:"overlap_policy"`
+	LastRunTime     time.Time       `json:"last_run_time,omitempty"`
+	LastTaskID      string          `json:"last_task_id,omitempty"`
+	CreatedAt       time.Time       `json:"created_at"`
+	UpdatedAt       time.Time       `json:"updated_at"`
+}
+
+// ScheduleStore 定时巡检存储接口
+type ScheduleStore = Store[Schedule]
@@ -0,0 +1,23 @@
+package model
+
+// Store 持久化存储接口，按ID保存和删除对象，Agent 启动时加载所有已保存的对象
+type Store[T any] interface {
+	// Save 保存对象，已存在时覆盖
+	Save(v *T) error
+	// Delete 按ID删除对象，对象不存在时不返回错误
+	Delete(id string) error
+	// LoadAll 加载所有已保存的对象
+	LoadAll() ([]*T, error)
+}
+
+// memoryStore 内存存储，不做持久化，对象只保存在使用方自己的缓存中
+type memoryStore[T any] struct{}
+
+// NewMemoryStore 创建内存存储，Agent 重启后数据会丢失
+func NewMemoryStore[T any]() Store[T] {
+	return memoryStore[T]{}
+}
+
+func (memoryStore[T]) Save(v *T) error        { return nil }
+func (memoryStore[T]) Delete(id string) error { return nil }
+func (memoryStore[T]) LoadAll() ([]*T, error) { return nil, nil }
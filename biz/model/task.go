@@ -3,6 +3,8 @@ package model
 import (
 	"sync"
 	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
 )
 
 // TaskStatus 任务状态
@@ -21,6 +23,21 @@ const (
 	TaskStatusCanceled TaskStatus = "canceled"
 )
 
+// IsFinished 任务是否已结束
+func (s TaskStatus) IsFinished() bool {
+	return s == TaskStatusCompleted || s == TaskStatusFailed || s == TaskStatusCanceled
+}
+
+// TaskMode 任务执行模式
+type TaskMode string
+
+const (
+	// TaskModeSync 同步执行，等待任务完成后返回结果
+	TaskModeSync TaskMode = "sync"
+	// TaskModeAsync 异步执行，立即返回任务ID
+	TaskModeAsync TaskMode = "async"
+)
+
 // ResultStatus 结果状态
 type ResultStatus string
 
@@ -33,63 +50,152 @@ const (
 	ResultStatusCritical ResultStatus = "critical"
 	// ResultStatusFailed 失败
 	ResultStatusFailed ResultStatus = "failed"
+	// ResultStatusSkipped 执行条件不满足，未执行
+	ResultStatusSkipped ResultStatus = "skipped"
+)
+
+// RetryCondition 任务项重试条件
+type RetryCondition string
+
+const (
+	// RetryOnError 执行器返回错误（包括超时）时重试
+	RetryOnError RetryCondition = "error"
+	// RetryOnFailed 结果为失败时重试，失败表示执行器未能完成检查，如连接不上目标
+	RetryOnFailed RetryCondition = "failed"
+	// RetryOnCritical 结果为严重时重试，严重是检查发现的问题，需要显式指定
+	RetryOnCritical RetryCondition = "critical"
+	// RetryOnWarning 结果为警告时重试
+	RetryOnWarning RetryCondition = "warning"
 )
 
 // TaskItem 任务项
 type TaskItem struct {
-	ID     uint                   `json:"id"`
-	Name   string                 `json:"name"`
-	Type   string                 `json:"type"`
-	Params map[string]interface{} `json:"params"`
+	ID             uint                   `json:"id"`
+	Name           string                 `json:"name"`
+	Type           string                 `json:"type"`
+	Params         map[string]interface{} `json:"params"`
+	DependsOn      []uint                 `json:"depends_on,omitempty"`      // 依赖的任务项ID
+	TimeoutSeconds int                    `json:"timeout_seconds,omitempty"` // 单次执行超时(秒)，<=0 时使用执行器配置
+	Retries        int                    `json:"retries,omitempty"`         // 最大重试次数
+	RetryBackoff   int                    `json:"retry_backoff,omitempty"`   // 重试间隔(秒)
+	RetryOn        []RetryCondition       `json:"retry_on,omitempty"`        // 重试条件，默认 error 和 failed，不重试严重和警告
+	When           string                 `json:"when,omitempty"`            // 执行条件，引用之前任务项的结果，不满足时跳过
+	Weight         float64                `json:"weight,omitempty"`          // 健康评分权重，<=0 时为1
+	HistoryKey     string                 `json:"history_key,omitempty"`     // 结果历史标识，默认按执行器类型和参数区分
+}
+
+// TaskAttempt 任务项的单次执行记录
+type TaskAttempt struct {
+	Attempt   int          `json:"attempt"` // 第几次执行，从1开始
+	Status    ResultStatus `json:"status"`
+	Message   string       `json:"message"`
+	Error     string       `json:"error,omitempty"` // 执行器返回的错误
+	StartTime time.Time    `json:"start_time"`
+	Duration  int64        `json:"duration"`
 }
 
 // TaskResult 任务结果
 type TaskResult struct {
-	ItemID   uint         `json:"item_id"`
-	Status   ResultStatus `json:"status"`
-	Value    string       `json:"value"`
-	Message  string       `json:"message"`
-	Details  string       `json:"details"`
-	Duration int64        `json:"duration"`
+	ItemID   uint              `json:"item_id"`
+	Status   ResultStatus      `json:"status"`
+	Value    string            `json:"value"`
+	Message  string            `json:"message"`
+	Details  string            `json:"details"`
+	Duration int64             `json:"duration"`
+	Attempts []TaskAttempt     `json:"attempts,omitempty"` // 配置了重试时记录每次执行情况
+	Labels   map[string]string `json:"labels,omitempty"`   // 结果对应的标签，如指标查询结果的 instance
+
+	Transition     ResultTransition `json:"transition,omitempty"`      // 相对同一巡检项上一次结果的变化
+	PreviousStatus ResultStatus     `json:"previous_status,omitempty"` // 上一次结果状态
+	PreviousValue  string           `json:"previous_value,omitempty"`  // 上一次结果值
+	Delta          *float64         `json:"delta,omitempty"`           // 结果值与上一次的差值，两次都是数字时才有
+	WaitTime       int64            `json:"wait_time,omitempty"`       // 等待执行器限流的时间(毫秒)，包含在 Duration 中
+	Final          bool             `json:"-"`                         // 重试也不会改变的结果，如 SSH 主机密钥不一致，任何重试条件下都不重试
 }
 
 // Task 任务对象，包含任务信息和执行结果
 type Task struct {
-	ID        string        `json:"task_id"`
-	Status    TaskStatus    `json:"status"`
-	Items     []TaskItem    `json:"items"`
-	Results   []TaskResult  `json:"results"`
-	Error     string        `json:"error,omitempty"`
-	StartTime time.Time     `json:"start_time"`
-	EndTime   time.Time     `json:"end_time,omitempty"`
-	Timeout   int           `json:"timeout"`
-	Cancel    chan struct{} `json:"-"` // 用于发送取消信号
+	ID              string        `json:"task_id"`
+	Status          TaskStatus    `json:"status"`
+	Items           []TaskItem    `json:"items"`
+	Results         []TaskResult  `json:"results"`
+	Error           string        `json:"error,omitempty"`
+	StartTime       time.Time     `json:"start_time"`
+	EndTime         time.Time     `json:"end_time,omitempty"`
+	Timeout         int           `json:"timeout"`
+	Parallelism     int           `json:"parallelism,omitempty"`      // 任务项最大并发数，<=0 时使用默认配置
+	Priority        int           `json:"priority,omitempty"`         // 优先级，数值越大越先执行
+	ExecuteTime     time.Time     `json:"execute_time,omitempty"`     // 开始执行时间，StartTime 为提交时间
+	Interrupted     bool          `json:"interrupted,omitempty"`      // 是否因 Agent 重启被中断过
+	ScheduleID      string        `json:"schedule_id,omitempty"`      // 由定时巡检提交时的定时巡检ID
+	IdempotencyKey  string        `json:"idempotency_key,omitempty"`  // 提交时的幂等键
+	PayloadHash     string        `json:"payload_hash,omitempty"`     // 提交内容摘要，用于识别同一幂等键下的不同请求
+	TemplateName    string        `json:"template,omitempty"`         // 引用的巡检模板名称
+	TemplateVersion int           `json:"template_version,omitempty"` // 引用的巡检模板版本
+	Cancel          chan struct{} `json:"-"`                          // 用于发送取消信号
+	Done            chan struct{} `json:"-"`                          // 任务结束时关闭
 }
 
 // TaskCallback 任务回调信息
 type TaskCallback struct {
-	TaskID    string       `json:"task_id"`
-	Status    TaskStatus   `json:"status"`
-	Results   []TaskResult `json:"results"`
-	StartTime time.Time    `json:"start_time"`
-	EndTime   time.Time    `json:"end_time"`
-	Error     string       `json:"error,omitempty"`
+	TaskID      string         `json:"task_id"`
+	Status      TaskStatus     `json:"status"`
+	Results     []TaskResult   `json:"results"`
+	StartTime   time.Time      `json:"start_time"`
+	EndTime     time.Time      `json:"end_time"`
+	Error       string         `json:"error,omitempty"`
+	Interrupted bool           `json:"interrupted,omitempty"`
+	ScheduleID  string         `json:"schedule_id,omitempty"`
+	Summary     TaskHealth     `json:"summary"`
+	Changes     []ResultChange `json:"changes,omitempty"` // 相对上一次执行发生变化的任务项
+}
+
+// TaskHealth 任务健康汇总
+type TaskHealth struct {
+	Counts      map[ResultStatus]int `json:"counts"`                 // 各结果状态的任务项数
+	WorstStatus ResultStatus         `json:"worst_status,omitempty"` // 最严重的结果状态，没有结果时为空
+	Score       *float64             `json:"score,omitempty"`        // 按任务项权重计算的健康分(0-100)，没有可计分的任务项时为空
+	TopFailures []TaskFailure        `json:"top_failures,omitempty"` // 最严重的异常任务项
+}
+
+// TaskFailure 健康汇总中的异常任务项
+type TaskFailure struct {
+	ItemID  uint         `json:"item_id"`
+	Name    string       `json:"name"`
+	Status  ResultStatus `json:"status"`
+	Message string       `json:"message"`
+	Weight  float64      `json:"weight"`
 }
 
-// TaskCache 任务缓存，保存所有任务状态和结果
+// TaskStore 任务存储接口，TaskCache 通过它持久化任务，使任务在 Agent 重启后可以恢复
+type TaskStore = Store[Task]
+
+// TaskCache 任务缓存，保存所有任务状态和结果。
+// 修改任务时在锁内复制任务，在锁外持久化，避免写文件阻塞其他任务的读写
 type TaskCache struct {
-	tasks map[string]*Task
-	mutex sync.RWMutex
+	tasks    map[string]*Task
+	versions map[string]*taskVersion // 每个任务的修改版本，用于丢弃过时的持久化
+	store    TaskStore
+	mutex    sync.RWMutex
 	// 清理配置
 	maxAge      time.Duration // 最大保留时间
 	cleanTicker *time.Ticker  // 清理定时器
 }
 
-// NewTaskCache 创建新的任务缓存
-func NewTaskCache() *TaskCache {
+// NewTaskCache 创建新的任务缓存，maxAge<=0 时默认保留24小时
+func NewTaskCache(store TaskStore, maxAge time.Duration) *TaskCache {
+	if store == nil {
+		store = NewMemoryStore[Task]()
+	}
+	if maxAge <= 0 {
+		maxAge = 24 * time.Hour
+	}
+
 	cache := &TaskCache{
 		tasks:       make(map[string]*Task),
-		maxAge:      24 * time.Hour, // 默认保留24小时
+		versions:    make(map[string]*taskVersion),
+		store:       store,
+		maxAge:      maxAge,
 		cleanTicker: time.NewTicker(1 * time.Hour),
 	}
 
@@ -109,25 +215,130 @@ func (c *TaskCache) startCleaner() {
 // cleanExpiredTasks 清理过期任务
 func (c *TaskCache) cleanExpiredTasks() {
 	c.mutex.Lock()
-	defer c.mutex.Unlock()
-
 	now := time.Now()
+	expired := make(map[string]*taskVersion)
 	for id, task := range c.tasks {
 		// 清理已完成且过期的任务
-		if (task.Status == TaskStatusCompleted ||
-			task.Status == TaskStatusFailed ||
-			task.Status == TaskStatusCanceled) &&
-			task.EndTime.Add(c.maxAge).Before(now) {
-			delete(c.tasks, id)
+		if task.Status.IsFinished() && task.EndTime.Add(c.maxAge).Before(now) {
+			expired[id] = c.removeLocked(id)
 		}
 	}
+	c.mutex.Unlock()
+
+	for id, version := range expired {
+		c.delete(id, version)
+	}
+}
+
+// taskVersion 任务的修改版本和已持久化的版本
+type taskVersion struct {
+	mutex   sync.Mutex // 串行化同一任务的持久化
+	current uint64     // 最近一次修改的版本，由 TaskCache 的锁保护
+	saved   uint64     // 已持久化的版本，由 mutex 保护
+}
+
+// taskRemoved 任务已删除时的持久化版本，之后的保存都被丢弃
+const taskRemoved = ^uint64(0)
+
+// snapshotLocked 记录一次修改并复制任务用于持久化，调用方需持有锁
+func (c *TaskCache) snapshotLocked(task *Task) (Task, *taskVersion, uint64) {
+	version, exists := c.versions[task.ID]
+	if !exists {
+		version = &taskVersion{}
+		c.versions[task.ID] = version
+	}
+	version.current++
+
+	snapshot := *task
+	snapshot.Results = append([]TaskResult(nil), task.Results...)
+	return snapshot, version, version.current
+}
+
+// persist 在锁外持久化任务副本，已保存更新的版本或任务已删除时跳过
+func (c *TaskCache) persist(task Task, version *taskVersion, current uint64) {
+	version.mutex.Lock()
+	defer version.mutex.Unlock()
+
+	if current <= version.saved {
+		return
+	}
+	if err := c.store.Save(&task); err != nil {
+		hlog.Errorf("保存任务失败: %s, %v", task.ID, err)
+		return
+	}
+	version.saved = current
+}
+
+// removeLocked 从缓存中删除任务，返回任务的版本用于删除存储，调用方需持有锁
+func (c *TaskCache) removeLocked(taskID string) *taskVersion {
+	delete(c.tasks, taskID)
+	version, exists := c.versions[taskID]
+	if !exists {
+		version = &taskVersion{}
+	}
+	delete(c.versions, taskID)
+	return version
+}
+
+// delete 在锁外删除任务存储，并阻止之后完成的保存重新写入
+func (c *TaskCache) delete(taskID string, version *taskVersion) {
+	version.mutex.Lock()
+	defer version.mutex.Unlock()
+
+	version.saved = taskRemoved
+	if err := c.store.Delete(taskID); err != nil {
+		hlog.Errorf("删除任务存储失败: %s, %v", taskID, err)
+	}
+}
+
+// LoadTasks 从存储中加载任务到缓存，返回加载的任务
+func (c *TaskCache) LoadTasks() ([]*Task, error) {
+	tasks, err := c.store.LoadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for _, task := range tasks {
+		c.tasks[task.ID] = task
+	}
+	return tasks, nil
+}
+
+// SaveTask 在持有锁的情况下修改任务，之后持久化
+func (c *TaskCache) SaveTask(taskID string, update func(task *Task)) bool {
+	c.mutex.Lock()
+	task, exists := c.tasks[taskID]
+	if !exists {
+		c.mutex.Unlock()
+		return false
+	}
+	update(task)
+	snapshot, version, current := c.snapshotLocked(task)
+	c.mutex.Unlock()
+
+	c.persist(snapshot, version, current)
+	return true
 }
 
 // AddTask 添加任务
 func (c *TaskCache) AddTask(task *Task) {
 	c.mutex.Lock()
-	defer c.mutex.Unlock()
 	c.tasks[task.ID] = task
+	snapshot, version, current := c.snapshotLocked(task)
+	c.mutex.Unlock()
+
+	c.persist(snapshot, version, current)
+}
+
+// RemoveTask 删除任务
+func (c *TaskCache) RemoveTask(taskID string) {
+	c.mutex.Lock()
+	version := c.removeLocked(taskID)
+	c.mutex.Unlock()
+
+	c.delete(taskID, version)
 }
 
 // GetTask 获取任务
@@ -138,45 +349,65 @@ func (c *TaskCache) GetTask(taskID string) (*Task, bool) {
 	return task, exists
 }
 
-// UpdateTaskStatus 更新任务状态
-func (c *TaskCache) UpdateTaskStatus(taskID string, status TaskStatus) bool {
-	c.mutex.Lock()
-	defer c.mutex.Unlock()
+// GetTaskSnapshot 获取任务副本，结果列表会被复制，可在锁外安全读取
+func (c *TaskCache) GetTaskSnapshot(taskID string) (Task, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
 
 	task, exists := c.tasks[taskID]
 	if !exists {
-		return false
+		return Task{}, false
 	}
 
-	task.Status = status
-	if status == TaskStatusCompleted || status == TaskStatusFailed || status == TaskStatusCanceled {
-		task.EndTime = time.Now()
+	snapshot := *task
+	snapshot.Results = append([]TaskResult(nil), task.Results...)
+	return snapshot, true
+}
+
+// ListTaskSnapshots 获取所有任务的副本
+func (c *TaskCache) ListTaskSnapshots() []Task {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	tasks := make([]Task, 0, len(c.tasks))
+	for _, task := range c.tasks {
+		snapshot := *task
+		snapshot.Results = append([]TaskResult(nil), task.Results...)
+		tasks = append(tasks, snapshot)
 	}
+	return tasks
+}
 
-	return true
+// UpdateTaskStatus 更新任务状态
+func (c *TaskCache) UpdateTaskStatus(taskID string, status TaskStatus) bool {
+	return c.SaveTask(taskID, func(task *Task) {
+		task.Status = status
+		if status.IsFinished() {
+			task.EndTime = time.Now()
+		}
+	})
 }
 
 // UpdateTaskResult 更新任务结果
 func (c *TaskCache) UpdateTaskResult(taskID string, results []TaskResult) bool {
-	c.mutex.Lock()
-	defer c.mutex.Unlock()
-
-	task, exists := c.tasks[taskID]
-	if !exists {
-		return false
-	}
+	return c.SaveTask(taskID, func(task *Task) {
+		task.Results = results
+	})
+}
 
-	task.Results = results
-	return true
+// AddTaskResult 追加单个任务项结果，用于执行过程中保存进度
+func (c *TaskCache) AddTaskResult(taskID string, result TaskResult) bool {
+	return c.SaveTask(taskID, func(task *Task) {
+		task.Results = append(task.Results, result)
+	})
 }
 
 // CancelTask 取消任务
 func (c *TaskCache) CancelTask(taskID string) bool {
 	c.mutex.Lock()
-	defer c.mutex.Unlock()
-
 	task, exists := c.tasks[taskID]
-	if !exists || task.Status != TaskStatusRunning {
+	if !exists || (task.Status != TaskStatusPending && task.Status != TaskStatusRunning) {
+		c.mutex.Unlock()
 		return false
 	}
 
@@ -184,6 +415,9 @@ func (c *TaskCache) CancelTask(taskID string) bool {
 	close(task.Cancel)
 	task.Status = TaskStatusCanceled
 	task.EndTime = time.Now()
+	snapshot, version, current := c.snapshotLocked(task)
+	c.mutex.Unlock()
 
+	c.persist(snapshot, version, current)
 	return true
 }
@@ -0,0 +1,104 @@
+package model
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// blockingTaskStore 保存时阻塞到 release 关闭，记录每次保存的结果数
+type blockingTaskStore struct {
+	release chan struct{}
+	mu      sync.Mutex
+	saved   map[string]int
+	deleted map[string]bool
+}
+
+func newBlockingTaskStore() *blockingTaskStore {
+	return &blockingTaskStore{release: make(chan struct{}), saved: make(map[string]int), deleted: make(map[string]bool)}
+}
+
+func (s *blockingTaskStore) Save(task *Task) error {
+	<-s.release
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.saved[task.ID] = len(task.Results)
+	return nil
+}
+
+func (s *blockingTaskStore) Delete(taskID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deleted[taskID] = true
+	delete(s.saved, taskID)
+	return nil
+}
+
+func (s *blockingTaskStore) LoadAll() ([]*Task, error) { return nil, nil }
+
+func TestTaskCachePersistsOutsideLock(t *testing.T) {
+	store := newBlockingTaskStore()
+	cache := NewTaskCache(store, 0)
+	close(store.release)
+	cache.AddTask(&Task{ID: "a"})
+	cache.AddTask(&Task{ID: "b"})
+	store.release = make(chan struct{})
+
+	// 任务 a 的保存阻塞时，任务 b 仍然可以读写
+	done := make(chan struct{})
+	go func() {
+		cache.AddTaskResult("a", TaskResult{ItemID: 1})
+		close(done)
+	}()
+	updated := make(chan struct{})
+	go func() {
+		if _, exists := cache.GetTaskSnapshot("b"); !exists {
+			t.Error("task b not found")
+		}
+		close(updated)
+	}()
+	select {
+	case <-updated:
+	case <-time.After(time.Second):
+		t.Fatal("reading task b blocked while task a was being saved")
+	}
+
+	close(store.release)
+	<-done
+	if got := store.saved["a"]; got != 1 {
+		t.Fatalf("saved results = %d, want 1", got)
+	}
+}
+
+func TestTaskCacheDropsStaleSaves(t *testing.T) {
+	store := newBlockingTaskStore()
+	close(store.release)
+	cache := NewTaskCache(store, 0)
+	cache.AddTask(&Task{ID: "a"})
+
+	// 版本更新的保存先完成时，过时的保存被丢弃
+	cache.mutex.Lock()
+	task := cache.tasks["a"]
+	task.Results = append(task.Results, TaskResult{ItemID: 1})
+	stale, version, staleVersion := cache.snapshotLocked(task)
+	task.Results = append(task.Results, TaskResult{ItemID: 2})
+	latest, _, latestVersion := cache.snapshotLocked(task)
+	cache.mutex.Unlock()
+
+	cache.persist(latest, version, latestVersion)
+	cache.persist(stale, version, staleVersion)
+	if got := store.saved["a"]; got != 2 {
+		t.Fatalf("saved results = %d, want 2", got)
+	}
+
+	// 删除后完成的保存不会重新写入任务
+	cache.mutex.Lock()
+	task.Status = TaskStatusCompleted
+	pending, version, pendingVersion := cache.snapshotLocked(task)
+	cache.mutex.Unlock()
+	cache.RemoveTask("a")
+	cache.persist(pending, version, pendingVersion)
+	if _, exists := store.saved["a"]; exists || !store.deleted["a"] {
+		t.Fatalf("task a saved after delete: saved=%v deleted=%v", store.saved, store.deleted)
+	}
+}
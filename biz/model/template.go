@@ -0,0 +1,45 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// TemplateSource 巡检模板来源
+type TemplateSource string
+
+const (
+	// TemplateSourceFile 从配置目录加载，只能通过修改文件更新
+	TemplateSourceFile TemplateSource = "file"
+	// TemplateSourceAPI 通过接口创建
+	TemplateSourceAPI TemplateSource = "api"
+)
+
+// TemplateVariable 巡检模板变量，任务项名称和字符串参数中的 ${name} 会被替换为变量值
+type TemplateVariable struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Default     string `json:"default,omitempty"`  // 默认值，提交任务时未提供变量时使用
+	Required    bool   `json:"required,omitempty"` // 是否必须在提交任务时提供
+}
+
+// Template 巡检模板，同名模板的每次修改都会生成新的版本
+type Template struct {
+	Name        string             `json:"name"`
+	Version     int                `json:"version"` // 版本号，从1开始递增
+	Description string             `json:"description,omitempty"`
+	Variables   []TemplateVariable `json:"variables,omitempty"`
+	Items       []TaskItem         `json:"items"`
+	Timeout     int                `json:"timeout,omitempty"`     // 任务超时时间(秒)，提交任务未指定时使用
+	Parallelism int                `json:"parallelism,omitempty"` // 任务项最大并发数，提交任务未指定时使用
+	Source      TemplateSource     `json:"source,omitempty"`
+	CreatedAt   time.Time          `json:"created_at"`
+}
+
+// TemplateStore 巡检模板存储接口，只保存通过接口创建的模板，每个版本单独保存，ID 由 TemplateKey 生成
+type TemplateStore = Store[Template]
+
+// TemplateKey 模板版本在存储中的ID
+func TemplateKey(name string, version int) string {
+	return fmt.Sprintf("%s@v%d", name, version)
+}
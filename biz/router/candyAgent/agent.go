@@ -31,16 +31,46 @@ func Register(r *server.Hertz) {
 		_alert_rules0.GET("/:name", append(_getalertruleMw(), candyAgent.GetAlertRule)...)
 		{
 			_v1 := _api.Group("/v1", _v1Mw()...)
+			_v1.GET("/callbacks", append(_listcallbacksMw(), candyAgent.ListCallbacks)...)
+			_callbacks := _v1.Group("/callbacks", _callbacksMw()...)
+			{
+				_task_id := _callbacks.Group("/:task_id", _task_idMw()...)
+				_task_id.POST("/redeliver", append(_redelivercallbackMw(), candyAgent.RedeliverCallback)...)
+			}
 			_v1.POST("/config", append(_updateconfigMw(), candyAgent.UpdateConfig)...)
+			_v1.GET("/executors", append(_listexecutorsMw(), candyAgent.ListExecutors)...)
 			_v1.POST("/task", append(_executetaskMw(), candyAgent.ExecuteTask)...)
 			{
 				_heartbeat := _v1.Group("/heartbeat", _heartbeatMw()...)
 				_heartbeat.POST("/:agent_id", append(_sendheartbeatMw(), candyAgent.SendHeartbeat)...)
 			}
+			{
+				_results := _v1.Group("/results", _resultsMw()...)
+				_results.GET("/:task_id", append(_gettaskresultMw(), candyAgent.GetTaskResult)...)
+			}
+			_v1.POST("/schedules", append(_createscheduleMw(), candyAgent.CreateSchedule)...)
+			_v1.GET("/schedules", append(_listschedulesMw(), candyAgent.ListSchedules)...)
+			{
+				_schedules := _v1.Group("/schedules", _schedulesMw()...)
+				_schedules.GET("/:schedule_id", append(_getscheduleMw(), candyAgent.GetSchedule)...)
+				_schedules.PUT("/:schedule_id", append(_updatescheduleMw(), candyAgent.UpdateSchedule)...)
+				_schedules.DELETE("/:schedule_id", append(_deletescheduleMw(), candyAgent.DeleteSchedule)...)
+			}
+			_v1.GET("/tasks", append(_listtasksMw(), candyAgent.ListTasks)...)
 			{
 				_tasks := _v1.Group("/tasks", _tasksMw()...)
 				_tasks.GET("/:task_id", append(_gettaskstatusMw(), candyAgent.GetTaskStatus)...)
 				_tasks.DELETE("/:task_id", append(_canceltaskMw(), candyAgent.CancelTask)...)
+				_task_id0 := _tasks.Group("/:task_id", _task_id0Mw()...)
+				_task_id0.GET("/events", append(_streamtaskeventsMw(), candyAgent.StreamTaskEvents)...)
+			}
+			_v1.POST("/templates", append(_createtemplateMw(), candyAgent.CreateTemplate)...)
+			_v1.GET("/templates", append(_listtemplatesMw(), candyAgent.ListTemplates)...)
+			{
+				_templates := _v1.Group("/templates", _templatesMw()...)
+				_templates.GET("/:name", append(_gettemplateMw(), candyAgent.GetTemplate)...)
+				_templates.PUT("/:name", append(_updatetemplateMw(), candyAgent.UpdateTemplate)...)
+				_templates.DELETE("/:name", append(_deletetemplateMw(), candyAgent.DeleteTemplate)...)
 			}
 		}
 	}
@@ -148,3 +148,108 @@ func _tasksMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _resultsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listcallbacksMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _callbacksMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _task_idMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _redelivercallbackMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createscheduleMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listschedulesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _schedulesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getscheduleMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updatescheduleMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deletescheduleMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _task_id0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _streamtaskeventsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listtasksMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createtemplateMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listtemplatesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _templatesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _gettemplateMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updatetemplateMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deletetemplateMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listexecutorsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
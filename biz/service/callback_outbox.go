@@ -0,0 +1,427 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.mokaz111.com/candy-agent/biz/model"
+	"github.mokaz111.com/candy-agent/conf"
+)
+
+const (
+	// CallbackSignatureHeader 回调签名请求头，值为 sha256=<hex>
+	CallbackSignatureHeader = "X-Candy-Signature"
+	// CallbackTimestampHeader 回调签名时间戳请求头，Unix秒
+	CallbackTimestampHeader = "X-Candy-Timestamp"
+	// CallbackAttemptHeader 回调投递次数请求头
+	CallbackAttemptHeader = "X-Candy-Attempt"
+)
+
+// CallbackOutbox 回调发件箱，持久化未送达的任务回调并按指数退避重试
+type CallbackOutbox struct {
+	url            string
+	apiKey         string
+	client         *http.Client
+	store          model.CallbackStore
+	records        map[string]*model.CallbackRecord
+	versions       map[string]*callbackVersion // 任务ID -> 回调记录的修改版本，用于丢弃过时的持久化
+	delivering     map[string]bool             // 正在投递的任务ID，避免同一回调并发投递
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	wakeup         chan struct{}
+	mutex          sync.Mutex
+}
+
+var (
+	callbackOutboxInstance *CallbackOutbox
+	callbackOutboxOnce     sync.Once
+)
+
+// GetCallbackOutbox 获取回调发件箱单例，首次调用时加载未送达的回调并启动投递循环
+func GetCallbackOutbox() *CallbackOutbox {
+	callbackOutboxOnce.Do(func() {
+		cfg := conf.GetConf().Callback
+
+		timeout := cfg.Timeout
+		if timeout <= 0 {
+			timeout = 10 // 默认10秒
+		}
+		maxAttempts := cfg.MaxAttempts
+		if maxAttempts <= 0 {
+			maxAttempts = 10 // 默认最多投递10次
+		}
+		initialBackoff := cfg.InitialBackoff
+		if initialBackoff <= 0 {
+			initialBackoff = 5 // 默认首次重试间隔5秒
+		}
+		maxBackoff := cfg.MaxBackoff
+		if maxBackoff <= 0 {
+			maxBackoff = 600 // 默认最大重试间隔10分钟
+		}
+
+		callbackOutboxInstance = &CallbackOutbox{
+			url:            conf.GetConf().Server.CallbackURL,
+			apiKey:         conf.GetConf().Server.APIKey,
+			client:         &http.Client{Timeout: time.Duration(timeout) * time.Second},
+			store:          newCallbackStore(),
+			records:        make(map[string]*model.CallbackRecord),
+			versions:       make(map[string]*callbackVersion),
+			delivering:     make(map[string]bool),
+			maxAttempts:    maxAttempts,
+			initialBackoff: time.Duration(initialBackoff) * time.Second,
+			maxBackoff:     time.Duration(maxBackoff) * time.Second,
+			wakeup:         make(chan struct{}, 1),
+		}
+		callbackOutboxInstance.load()
+
+		go callbackOutboxInstance.run()
+	})
+	return callbackOutboxInstance
+}
+
+// newCallbackStore 根据配置创建回调存储，创建失败时退化为内存存储
+func newCallbackStore() model.CallbackStore {
+	cfg := conf.GetConf().Callback
+	return newStore(storeConfig{name: "回调", kind: cfg.Store, dir: cfg.StoreDir, defaultDir: "data/callbacks"},
+		func(record *model.CallbackRecord) string { return record.TaskID })
+}
+
+// load 加载重启前未送达的回调
+func (o *CallbackOutbox) load() {
+	records, err := o.store.LoadAll()
+	if err != nil {
+		hlog.Errorf("加载未送达的回调失败: %v", err)
+		return
+	}
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	for _, record := range records {
+		o.records[record.TaskID] = record
+	}
+	if len(records) > 0 {
+		hlog.Infof("已加载 %d 个未送达的回调", len(records))
+	}
+}
+
+// Enqueue 将任务回调加入发件箱，同一任务未送达的旧回调会被替换
+func (o *CallbackOutbox) Enqueue(callback model.TaskCallback) {
+	if o.url == "" {
+		hlog.Warn("回调URL未配置，跳过回调")
+		return
+	}
+
+	now := time.Now()
+	record := &model.CallbackRecord{
+		TaskID:      callback.TaskID,
+		Payload:     callback,
+		State:       model.CallbackStatePending,
+		NextAttempt: now,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	o.mutex.Lock()
+	o.records[record.TaskID] = record
+	snapshot := *record
+	version, current := o.versionLocked(record.TaskID)
+	o.mutex.Unlock()
+
+	o.persist(snapshot, version, current)
+	o.notify()
+}
+
+// List 获取发件箱中的回调记录副本，按创建时间排序
+func (o *CallbackOutbox) List() []model.CallbackRecord {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	records := make([]model.CallbackRecord, 0, len(o.records))
+	for _, record := range o.records {
+		records = append(records, *record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].CreatedAt.Before(records[j].CreatedAt)
+	})
+	return records
+}
+
+// Redeliver 立即投递指定任务的回调，忽略退避时间和重试次数限制
+// 返回投递后的记录，投递成功时记录已从发件箱移除，返回 nil
+func (o *CallbackOutbox) Redeliver(taskID string) (*model.CallbackRecord, error) {
+	o.mutex.Lock()
+	record, exists := o.records[taskID]
+	if !exists {
+		o.mutex.Unlock()
+		return nil, fmt.Errorf("回调不存在: %s", taskID)
+	}
+	if o.delivering[taskID] {
+		o.mutex.Unlock()
+		return nil, fmt.Errorf("回调正在投递中: %s", taskID)
+	}
+	o.delivering[taskID] = true
+	o.mutex.Unlock()
+
+	err := o.deliver(record)
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	if current, exists := o.records[taskID]; exists {
+		snapshot := *current
+		return &snapshot, err
+	}
+	return nil, err
+}
+
+// notify 唤醒投递循环
+func (o *CallbackOutbox) notify() {
+	select {
+	case o.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// Flush 立即投递所有到期的回调并等待投递结束，用于关闭前尽量送达回调，ctx 结束时返回
+func (o *CallbackOutbox) Flush(ctx context.Context) {
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		o.dispatchDue()
+		if o.idle() {
+			return
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			hlog.Warnf("关闭前未能送达所有回调: %v", ctx.Err())
+			return
+		}
+	}
+}
+
+// idle 是否没有正在投递或已到期待投递的回调
+func (o *CallbackOutbox) idle() bool {
+	now := time.Now()
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	if len(o.delivering) > 0 {
+		return false
+	}
+	for _, record := range o.records {
+		if record.State == model.CallbackStatePending && !record.NextAttempt.After(now) {
+			return false
+		}
+	}
+	return true
+}
+
+// run 投递循环，定期投递到期的回调
+func (o *CallbackOutbox) run() {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-o.wakeup:
+		}
+		o.dispatchDue()
+	}
+}
+
+// dispatchDue 投递所有到期且未在投递中的回调
+func (o *CallbackOutbox) dispatchDue() {
+	now := time.Now()
+
+	o.mutex.Lock()
+	due := make([]*model.CallbackRecord, 0)
+	for taskID, record := range o.records {
+		if record.State != model.CallbackStatePending || o.delivering[taskID] || record.NextAttempt.After(now) {
+			continue
+		}
+		o.delivering[taskID] = true
+		due = append(due, record)
+	}
+	o.mutex.Unlock()
+
+	for _, record := range due {
+		go o.deliver(record)
+	}
+}
+
+// deliver 投递一次回调并根据结果更新记录，调用前需将任务标记为投递中
+func (o *CallbackOutbox) deliver(record *model.CallbackRecord) error {
+	o.mutex.Lock()
+	payload := record.Payload
+	attempt := record.Attempts + 1
+	o.mutex.Unlock()
+
+	err := o.post(payload, attempt)
+
+	o.mutex.Lock()
+	delete(o.delivering, record.TaskID)
+
+	// 投递期间同一任务有了新的回调，旧记录直接丢弃
+	if o.records[record.TaskID] != record {
+		o.mutex.Unlock()
+		return err
+	}
+
+	if err == nil {
+		delete(o.records, record.TaskID)
+		version, current := o.versionLocked(record.TaskID)
+		o.mutex.Unlock()
+
+		o.remove(record.TaskID, version, current)
+		hlog.Infof("成功发送任务回调: %s", record.TaskID)
+		return nil
+	}
+
+	now := time.Now()
+	record.Attempts = attempt
+	record.LastError = err.Error()
+	record.UpdatedAt = now
+	if record.Attempts >= o.maxAttempts {
+		record.State = model.CallbackStateExhausted
+		hlog.Errorf("任务回调投递失败且重试次数已用完: %s, %v", record.TaskID, err)
+	} else {
+		record.State = model.CallbackStatePending
+		record.NextAttempt = now.Add(o.backoff(record.Attempts))
+		hlog.Warnf("任务回调投递失败，将在 %s 重试: %s, %v", record.NextAttempt.Format(time.RFC3339), record.TaskID, err)
+	}
+	snapshot := *record
+	version, current := o.versionLocked(record.TaskID)
+	o.mutex.Unlock()
+
+	o.persist(snapshot, version, current)
+	return err
+}
+
+// post 发送回调请求，带上API Key和HMAC签名
+func (o *CallbackOutbox) post(payload model.TaskCallback, attempt int) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("序列化回调数据失败: %v", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, o.url, bytes.NewBuffer(data))
+	if err != nil {
+		return fmt.Errorf("创建回调请求失败: %v", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(CallbackAttemptHeader, strconv.Itoa(attempt))
+
+	// 设置API Key和签名 (如果配置了)
+	if o.apiKey != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set("X-API-Key", o.apiKey)
+		req.Header.Set(CallbackTimestampHeader, timestamp)
+		req.Header.Set(CallbackSignatureHeader, SignCallback(o.apiKey, timestamp, data))
+	}
+
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("发送回调请求失败: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("回调请求返回非2xx状态码: %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// backoff 计算第 attempts 次失败后的重试间隔，指数增长并加入随机抖动
+func (o *CallbackOutbox) backoff(attempts int) time.Duration {
+	delay := o.initialBackoff
+	for i := 1; i < attempts && delay < o.maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > o.maxBackoff {
+		delay = o.maxBackoff
+	}
+
+	// 在 [delay/2, delay) 之间随机，避免大量回调同时重试
+	half := delay / 2
+	if half <= 0 {
+		return delay
+	}
+	return half + time.Duration(rand.Int63n(int64(half)))
+}
+
+// callbackVersion 回调记录的修改版本和已持久化的版本
+type callbackVersion struct {
+	mutex   sync.Mutex // 串行化同一任务回调记录的持久化
+	current uint64     // 最近一次修改的版本，由 CallbackOutbox 的锁保护
+	saved   uint64     // 已持久化的版本，由 mutex 保护
+}
+
+// versionLocked 记录一次对回调记录的修改，返回修改后的版本，调用方需持有锁
+func (o *CallbackOutbox) versionLocked(taskID string) (*callbackVersion, uint64) {
+	version, exists := o.versions[taskID]
+	if !exists {
+		version = &callbackVersion{}
+		o.versions[taskID] = version
+	}
+	version.current++
+	return version, version.current
+}
+
+// persist 在锁外保存回调记录副本，已持久化更新的版本时跳过
+func (o *CallbackOutbox) persist(record model.CallbackRecord, version *callbackVersion, current uint64) {
+	version.mutex.Lock()
+	defer version.mutex.Unlock()
+
+	if current <= version.saved {
+		return
+	}
+	if err := o.store.Save(&record); err != nil {
+		hlog.Errorf("保存回调记录失败: %s, %v", record.TaskID, err)
+		return
+	}
+	version.saved = current
+}
+
+// remove 在锁外删除回调记录存储，已持久化更新的版本时跳过；之后没有新的修改时释放版本
+func (o *CallbackOutbox) remove(taskID string, version *callbackVersion, current uint64) {
+	version.mutex.Lock()
+	if current > version.saved {
+		if err := o.store.Delete(taskID); err != nil {
+			hlog.Errorf("删除回调记录失败: %s, %v", taskID, err)
+		} else {
+			version.saved = current
+		}
+	}
+	version.mutex.Unlock()
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	if o.versions[taskID] == version && version.current == current {
+		delete(o.versions, taskID)
+	}
+}
+
+// SignCallback 计算回调签名：HMAC-SHA256(apiKey, timestamp + "." + body)
+func SignCallback(apiKey, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(apiKey))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
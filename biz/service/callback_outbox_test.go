@@ -0,0 +1,205 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+)
+
+// newTestOutbox 创建不启动投递循环的发件箱
+func newTestOutbox(url string, maxAttempts int) *CallbackOutbox {
+	return &CallbackOutbox{
+		url:            url,
+		apiKey:         "secret",
+		client:         &http.Client{Timeout: time.Second},
+		store:          model.NewMemoryStore[model.CallbackRecord](),
+		records:        make(map[string]*model.CallbackRecord),
+		versions:       make(map[string]*callbackVersion),
+		delivering:     make(map[string]bool),
+		maxAttempts:    maxAttempts,
+		initialBackoff: time.Second,
+		maxBackoff:     8 * time.Second,
+		wakeup:         make(chan struct{}, 1),
+	}
+}
+
+func TestCallbackOutboxBackoff(t *testing.T) {
+	o := newTestOutbox("", 10)
+	tests := []struct {
+		attempts int
+		min, max time.Duration
+	}{
+		{attempts: 1, min: 500 * time.Millisecond, max: time.Second},
+		{attempts: 2, min: time.Second, max: 2 * time.Second},
+		{attempts: 3, min: 2 * time.Second, max: 4 * time.Second},
+		{attempts: 4, min: 4 * time.Second, max: 8 * time.Second},
+		{attempts: 20, min: 4 * time.Second, max: 8 * time.Second},
+	}
+	for _, tt := range tests {
+		for i := 0; i < 50; i++ {
+			if got := o.backoff(tt.attempts); got < tt.min || got >= tt.max {
+				t.Fatalf("backoff(%d) = %s, want [%s, %s)", tt.attempts, got, tt.min, tt.max)
+			}
+		}
+	}
+}
+
+func TestSignCallback(t *testing.T) {
+	body := []byte(`{"task_id":"t1"}`)
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte("1700000000." + string(body)))
+	want := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	if got := SignCallback("secret", "1700000000", body); got != want {
+		t.Fatalf("SignCallback = %s, want %s", got, want)
+	}
+	if SignCallback("other", "1700000000", body) == want {
+		t.Fatal("signature does not depend on the key")
+	}
+}
+
+func TestCallbackOutboxDeliver(t *testing.T) {
+	var fail atomic.Bool
+	fail.Store(true)
+	var lastAttempt atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		timestamp := r.Header.Get(CallbackTimestampHeader)
+		if r.Header.Get("X-API-Key") != "secret" || r.Header.Get(CallbackSignatureHeader) != SignCallback("secret", timestamp, body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		lastAttempt.Store(r.Header.Get(CallbackAttemptHeader))
+		if fail.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	o := newTestOutbox(server.URL, 2)
+	o.Enqueue(model.TaskCallback{TaskID: "t1", Status: model.TaskStatusCompleted})
+	record := o.records["t1"]
+
+	// 第一次失败后按退避时间等待重试
+	start := time.Now()
+	if err := o.deliver(record); err == nil {
+		t.Fatal("deliver succeeded, want error from the 500 response")
+	}
+	if record.State != model.CallbackStatePending || record.Attempts != 1 {
+		t.Fatalf("after first failure: state %s, attempts %d", record.State, record.Attempts)
+	}
+	if wait := record.NextAttempt.Sub(start); wait < 500*time.Millisecond || wait > 2*time.Second {
+		t.Fatalf("next attempt in %s, want about the initial backoff", wait)
+	}
+
+	// 重试次数用完后不再自动投递
+	if err := o.deliver(record); err == nil {
+		t.Fatal("deliver succeeded, want error")
+	}
+	if record.State != model.CallbackStateExhausted || record.Attempts != 2 {
+		t.Fatalf("after second failure: state %s, attempts %d", record.State, record.Attempts)
+	}
+	if got := lastAttempt.Load(); got != strconv.Itoa(2) {
+		t.Fatalf("attempt header = %v, want 2", got)
+	}
+	o.dispatchDue()
+	if len(o.delivering) != 0 {
+		t.Fatal("exhausted callback dispatched again")
+	}
+
+	// 手动重新投递成功后从发件箱移除
+	fail.Store(false)
+	remaining, err := o.Redeliver("t1")
+	if err != nil || remaining != nil {
+		t.Fatalf("Redeliver = %v, %v, want delivered", remaining, err)
+	}
+	if len(o.List()) != 0 {
+		t.Fatal("delivered callback still in the outbox")
+	}
+}
+
+// blockingCallbackStore 保存任务 block 的回调记录时阻塞到 release 关闭，记录已保存的任务ID
+type blockingCallbackStore struct {
+	block   string
+	release chan struct{}
+	mu      sync.Mutex
+	saved   map[string]bool
+}
+
+func (s *blockingCallbackStore) Save(record *model.CallbackRecord) error {
+	if record.TaskID == s.block {
+		<-s.release
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.saved[record.TaskID] = true
+	return nil
+}
+
+func (s *blockingCallbackStore) Delete(taskID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.saved, taskID)
+	return nil
+}
+
+func (s *blockingCallbackStore) LoadAll() ([]*model.CallbackRecord, error) { return nil, nil }
+
+func TestCallbackOutboxPersistsOutsideLock(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	store := &blockingCallbackStore{block: "slow", release: make(chan struct{}), saved: make(map[string]bool)}
+	o := newTestOutbox(server.URL, 3)
+	o.store = store
+
+	slow := make(chan struct{})
+	go func() {
+		o.Enqueue(model.TaskCallback{TaskID: "slow"})
+		close(slow)
+	}()
+	waitUntil(t, func() bool { return len(o.List()) == 1 })
+
+	// 任务 slow 的回调记录保存阻塞时，其他回调仍然可以入队和投递
+	fast := make(chan struct{})
+	go func() {
+		o.Enqueue(model.TaskCallback{TaskID: "fast"})
+		o.mutex.Lock()
+		record := o.records["fast"]
+		o.delivering["fast"] = true
+		o.mutex.Unlock()
+		if err := o.deliver(record); err != nil {
+			t.Errorf("deliver fast: %v", err)
+		}
+		close(fast)
+	}()
+	select {
+	case <-fast:
+	case <-time.After(time.Second):
+		t.Fatal("Enqueue blocked by another callback's save")
+	}
+
+	close(store.release)
+	<-slow
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if !store.saved["slow"] || store.saved["fast"] {
+		t.Errorf("stored callbacks = %v, want only slow", store.saved)
+	}
+	if _, exists := o.versions["fast"]; exists {
+		t.Error("version of delivered callback was not released")
+	}
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	candyAgent "github.mokaz111.com/candy-agent/hertz_gen/candyAgent"
+)
+
+type CreateScheduleService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewCreateScheduleService(Context context.Context, RequestContext *app.RequestContext) *CreateScheduleService {
+	return &CreateScheduleService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *CreateScheduleService) Run(req *candyAgent.ScheduleRequest) (resp *candyAgent.ScheduleResponse, err error) {
+	schedule, err := GetScheduler().Create(convertScheduleRequest(req))
+	if err != nil {
+		return nil, err
+	}
+
+	hlog.CtxInfof(h.Context, "Created schedule %s", schedule.ID)
+	return &candyAgent.ScheduleResponse{
+		Schedule: convertSchedule(schedule),
+		Message:  "定时巡检创建成功",
+	}, nil
+}
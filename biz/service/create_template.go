@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	candyAgent "github.mokaz111.com/candy-agent/hertz_gen/candyAgent"
+)
+
+type CreateTemplateService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewCreateTemplateService(Context context.Context, RequestContext *app.RequestContext) *CreateTemplateService {
+	return &CreateTemplateService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *CreateTemplateService) Run(req *candyAgent.TemplateRequest) (resp *candyAgent.TemplateResponse, err error) {
+	template, err := GetTemplateRegistry().Create(convertTemplateRequest(req))
+	if err != nil {
+		return nil, err
+	}
+
+	hlog.CtxInfof(h.Context, "Created template %s@v%d", template.Name, template.Version)
+	return &candyAgent.TemplateResponse{
+		Template: convertTemplate(template),
+		Message:  "巡检模板创建成功",
+	}, nil
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	candyAgent "github.mokaz111.com/candy-agent/hertz_gen/candyAgent"
+)
+
+type DeleteScheduleService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewDeleteScheduleService(Context context.Context, RequestContext *app.RequestContext) *DeleteScheduleService {
+	return &DeleteScheduleService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *DeleteScheduleService) Run(req *candyAgent.ScheduleRequest) (resp *candyAgent.ScheduleResponse, err error) {
+	if req.ScheduleId == "" {
+		return nil, fmt.Errorf("定时巡检ID不能为空")
+	}
+
+	if err := GetScheduler().Delete(req.ScheduleId); err != nil {
+		return nil, err
+	}
+
+	hlog.CtxInfof(h.Context, "Deleted schedule %s", req.ScheduleId)
+	return &candyAgent.ScheduleResponse{Message: "定时巡检删除成功"}, nil
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	candyAgent "github.mokaz111.com/candy-agent/hertz_gen/candyAgent"
+)
+
+type DeleteTemplateService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewDeleteTemplateService(Context context.Context, RequestContext *app.RequestContext) *DeleteTemplateService {
+	return &DeleteTemplateService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *DeleteTemplateService) Run(req *candyAgent.TemplateRequest) (resp *candyAgent.TemplateResponse, err error) {
+	if req.Name == "" {
+		return nil, fmt.Errorf("模板名称不能为空")
+	}
+
+	if err := GetTemplateRegistry().Delete(req.Name, int(req.Version)); err != nil {
+		return nil, err
+	}
+
+	hlog.CtxInfof(h.Context, "Deleted template %s (version %d)", req.Name, req.Version)
+	return &candyAgent.TemplateResponse{Message: "巡检模板删除成功"}, nil
+}
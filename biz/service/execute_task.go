@@ -3,11 +3,8 @@ package service
 import (
 	"context"
 	"fmt"
-	"sync"
-	"time"
 
 	"github.com/cloudwego/hertz/pkg/common/hlog"
-	"github.mokaz111.com/candy-agent/biz/executor"
 	"github.mokaz111.com/candy-agent/biz/model"
 
 	"github.com/cloudwego/hertz/pkg/app"
@@ -15,173 +12,119 @@ import (
 )
 
 type ExecuteTaskService struct {
-	RequestContext  *app.RequestContext
-	Context         context.Context
-	executorFactory *executor.ExecutorFactory
-	tasks           map[string]*model.Task
-	mutex           sync.RWMutex
+	RequestContext *app.RequestContext
+	Context        context.Context
 }
 
-func NewExecuteTaskService(Context context.Context, RequestContext *app.RequestContext, executorFactory *executor.ExecutorFactory) *ExecuteTaskService {
-	hlog.Infof("Creating new ExecuteTaskService")
-	return &ExecuteTaskService{RequestContext: RequestContext, Context: Context, executorFactory: executorFactory, tasks: make(map[string]*model.Task)}
+func NewExecuteTaskService(Context context.Context, RequestContext *app.RequestContext) *ExecuteTaskService {
+	return &ExecuteTaskService{RequestContext: RequestContext, Context: Context}
 }
 
 func (s *ExecuteTaskService) Run(req *candyAgent.TaskRequest) (resp *candyAgent.TaskResponse, err error) {
-	startTime := time.Now()
-	hlog.CtxInfof(s.Context, "Starting task execution: %s with %d items", req.TaskId, len(req.Items))
-
-	// 创建任务响应对象
-	resp = &candyAgent.TaskResponse{
-		TaskId:    req.TaskId,
-		Status:    candyAgent.TaskStatus_TASK_STATUS_COMPLETED,
-		Results:   make([]*candyAgent.TaskResult, 0, len(req.Items)),
-		StartTime: startTime.Format(time.RFC3339),
+	// 解析执行模式，默认同步执行
+	mode := model.TaskMode(req.Mode)
+	if mode == "" {
+		mode = model.TaskModeSync
 	}
-
-	// 创建上下文，支持超时控制
-	timeout := time.Duration(req.Timeout) * time.Second
-	if timeout <= 0 {
-		timeout = 30 * time.Second // 默认30秒超时
+	if mode != model.TaskModeSync && mode != model.TaskModeAsync {
+		return nil, fmt.Errorf("unsupported task mode: %s", req.Mode)
 	}
-	ctx, cancel := context.WithTimeout(s.Context, timeout)
-	defer cancel()
-
-	// 执行每个任务项
-	for _, item := range req.Items {
-		hlog.CtxInfof(ctx, "Processing task item: %s (ID: %d) for task: %s", item.Name, item.Id, req.TaskId)
-
-		// 创建任务项模型
-		modelItem := &model.TaskItem{
-			ID:     uint(item.Id),
-			Name:   item.Name,
-			Type:   item.Type,
-			Params: make(map[string]interface{}),
-		}
 
-		// 转换参数
-		for k, v := range item.Params {
-			modelItem.Params[k] = v
-		}
+	submitted := &model.Task{
+		ID:             req.TaskId,
+		Items:          convertTaskItems(req.Items),
+		Timeout:        int(req.Timeout),
+		Parallelism:    int(req.Parallelism),
+		Priority:       int(req.Priority),
+		IdempotencyKey: req.IdempotencyKey,
+	}
 
-		// 检查上下文是否已取消
-		if ctx.Err() != nil {
-			hlog.CtxWarnf(ctx, "Task execution interrupted: %v", ctx.Err())
-			resp.Status = candyAgent.TaskStatus_TASK_STATUS_FAILED
-			resp.Message = fmt.Sprintf("Task interrupted: %v", ctx.Err())
-			break
+	// 引用巡检模板时由模板生成任务项，未指定的超时和并发数使用模板配置
+	if req.Template != "" {
+		if len(req.Items) > 0 {
+			return nil, fmt.Errorf("不能同时指定 template 和 items")
 		}
-
-		// 获取执行器
-		executor, err := s.executorFactory.Create(item.Type)
+		template, items, err := GetTemplateRegistry().Render(req.Template, int(req.TemplateVersion), req.Variables)
 		if err != nil {
-			hlog.CtxErrorf(ctx, "Failed to get executor for item %d: %v", item.Id, err)
-			resp.Results = append(resp.Results, &candyAgent.TaskResult{
-				ItemId:   item.Id,
-				Status:   candyAgent.ResultStatus_RESULT_STATUS_FAILED,
-				Message:  fmt.Sprintf("Failed to get executor: %v", err),
-				Details:  fmt.Sprintf("Error creating executor of type %s: %v", item.Type, err),
-				Duration: time.Since(startTime).Milliseconds(),
-			})
-			continue
+			return nil, err
 		}
-
-		// 执行任务项
-		itemStartTime := time.Now()
-		result, err := executor.Execute(ctx, *modelItem)
-		executionDuration := time.Since(itemStartTime).Milliseconds()
-
-		if err != nil {
-			hlog.CtxErrorf(ctx, "Failed to execute item %d: %v", item.Id, err)
-			resp.Results = append(resp.Results, &candyAgent.TaskResult{
-				ItemId:   item.Id,
-				Status:   candyAgent.ResultStatus_RESULT_STATUS_FAILED,
-				Message:  fmt.Sprintf("Execution failed: %v", err),
-				Details:  fmt.Sprintf("Error executing task item: %v", err),
-				Duration: executionDuration,
-			})
-			continue
+		submitted.Items = items
+		submitted.TemplateName = template.Name
+		submitted.TemplateVersion = template.Version
+		if submitted.Timeout <= 0 {
+			submitted.Timeout = template.Timeout
+		}
+		if submitted.Parallelism <= 0 {
+			submitted.Parallelism = template.Parallelism
 		}
+	}
+	if submitted.Timeout <= 0 {
+		submitted.Timeout = 30 // 默认30秒
+	}
 
-		// 将结果添加到响应中
-		hlog.CtxInfof(ctx, "Successfully executed item %d for task %s: %s", item.Id, req.TaskId, result.Value)
-		resp.Results = append(resp.Results, &candyAgent.TaskResult{
-			ItemId:   item.Id,
-			Status:   s.convertResultStatus(result.Status),
-			Message:  result.Message,
-			Details:  result.Details,
-			Duration: result.Duration,
-		})
+	// 试运行只校验任务项和检查目标连通性，不创建任务
+	if req.DryRun {
+		return s.dryRun(submitted)
 	}
 
-	// 设置结束时间
-	endTime := time.Now()
-	resp.EndTime = endTime.Format(time.RFC3339)
-	resp.Message = fmt.Sprintf("Task completed in %d ms", time.Since(startTime).Milliseconds())
-
-	// 根据结果调整任务状态
-	if resp.Status != candyAgent.TaskStatus_TASK_STATUS_FAILED {
-		// 检查是否有任何任务项失败
-		hasFailures := false
-		for _, result := range resp.Results {
-			if result.Status == candyAgent.ResultStatus_RESULT_STATUS_FAILED {
-				hasFailures = true
-				break
-			}
-		}
+	hlog.CtxInfof(s.Context, "Submitting task %s with %d items in %s mode", req.TaskId, len(submitted.Items), mode)
 
-		if hasFailures {
-			// 使用WARNING状态代替COMPLETED_WITH_ERRORS
-			resp.Message = "Task completed with some failures"
-		}
+	// 通过任务管理器创建任务，由工作池异步执行
+	taskManager := GetTaskManager()
+	task, replayed, err := taskManager.SubmitTask(submitted)
+	if err != nil {
+		return nil, fmt.Errorf("创建任务失败: %w", err)
 	}
 
-	hlog.CtxInfof(s.Context, "Task %s completed with status %s in %d ms",
-		req.TaskId, resp.Status, time.Since(startTime).Milliseconds())
-	return resp, nil
-}
-
-// 转换结果状态
-func (s *ExecuteTaskService) convertResultStatus(status model.ResultStatus) candyAgent.ResultStatus {
-	switch status {
-	case model.ResultStatusNormal:
-		return candyAgent.ResultStatus_RESULT_STATUS_NORMAL
-	case model.ResultStatusWarning:
-		return candyAgent.ResultStatus_RESULT_STATUS_WARNING
-	case model.ResultStatusCritical:
-		return candyAgent.ResultStatus_RESULT_STATUS_FAILED // 将CRITICAL映射到FAILED
-	case model.ResultStatusFailed:
-		return candyAgent.ResultStatus_RESULT_STATUS_FAILED
-	default:
-		return candyAgent.ResultStatus_RESULT_STATUS_UNKNOWN
+	if replayed {
+		hlog.CtxInfof(s.Context, "Task %s already submitted with idempotency key %s, status %s", task.ID, req.IdempotencyKey, task.Status)
 	}
-}
 
-// GetTask 获取任务
-func (s *ExecuteTaskService) GetTask(taskID string) (*model.Task, error) {
-	s.mutex.RLock()
-	defer s.mutex.RUnlock()
+	// 异步模式立即返回任务ID，重复提交时返回已有任务的当前状态。
+	// 返回的任务是副本，工作协程可能已经开始修改缓存中的任务
+	if mode == model.TaskModeAsync {
+		resp = buildTaskResponse(task)
+		resp.Replayed = replayed
+		return resp, nil
+	}
 
-	task, ok := s.tasks[taskID]
-	if !ok {
-		return nil, fmt.Errorf("task not found: %s", taskID)
+	// 同步模式等待任务执行结束
+	task, err = taskManager.WaitTask(s.Context, task.ID)
+	if err != nil {
+		return nil, err
 	}
 
-	return task, nil
+	hlog.CtxInfof(s.Context, "Task %s finished with status %s", task.ID, task.Status)
+	resp = buildTaskResponse(task)
+	resp.Replayed = replayed
+	return resp, nil
 }
 
-// CleanupTasks 清理过期任务
-func (s *ExecuteTaskService) CleanupTasks(maxAge time.Duration) {
-	s.mutex.Lock()
-	defer s.mutex.Unlock()
-
-	now := time.Now()
-	for id, task := range s.tasks {
-		// 如果任务已完成且超过最大保留时间，则删除
-		if (task.Status == model.TaskStatusCompleted || task.Status == model.TaskStatusFailed) &&
-			task.EndTime.Add(maxAge).Before(now) {
-			delete(s.tasks, id)
-			hlog.Infof("Cleaned up task %s", id)
+// dryRun 试运行任务，返回每个任务项的校验和连通性检查结果
+func (s *ExecuteTaskService) dryRun(task *model.Task) (*candyAgent.TaskResponse, error) {
+	hlog.CtxInfof(s.Context, "Dry running task %s with %d items", task.ID, len(task.Items))
+
+	items, err := GetTaskManager().DryRun(s.Context, task)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &candyAgent.TaskResponse{
+		TaskId:      task.ID,
+		DryRun:      true,
+		DryRunItems: make([]*candyAgent.DryRunItem, 0, len(items)),
+	}
+	var valid, reachable int
+	for _, item := range items {
+		converted := convertDryRunItem(item)
+		if converted.Valid {
+			valid++
+		}
+		if item.ProbeStatus == ProbeReachable {
+			reachable++
 		}
+		resp.DryRunItems = append(resp.DryRunItems, converted)
 	}
+	resp.Message = fmt.Sprintf("Dry run: %d/%d items valid, %d reachable", valid, len(items), reachable)
+	return resp, nil
 }
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"github.mokaz111.com/candy-agent/biz/executor"
+	candyAgent "github.mokaz111.com/candy-agent/hertz_gen/candyAgent"
+)
+
+// convertExecutorSchema 转换执行器参数说明
+func convertExecutorSchema(schema executor.Schema) *candyAgent.ExecutorInfo {
+	info := &candyAgent.ExecutorInfo{
+		Name:        schema.Name,
+		Description: schema.Description,
+		Params:      make([]*candyAgent.ExecutorParam, 0, len(schema.Params)),
+	}
+	for _, param := range schema.Params {
+		info.Params = append(info.Params, &candyAgent.ExecutorParam{
+			Name:        param.Name,
+			Type:        string(param.Type),
+			Required:    param.Required,
+			Default:     param.Default,
+			Enum:        param.Enum,
+			Operations:  param.Operations,
+			Description: param.Description,
+			Secret:      param.Secret,
+		})
+	}
+	return info
+}
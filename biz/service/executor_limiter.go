@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.mokaz111.com/candy-agent/conf"
+	"golang.org/x/time/rate"
+)
+
+// defaultLimitKey 对未单独配置的执行器生效的限流配置
+const defaultLimitKey = "default"
+
+// limitGate 一组并发和速率限制，未配置的限制为 nil
+type limitGate struct {
+	inFlight chan struct{}
+	limiter  *rate.Limiter
+}
+
+// newLimitGate 根据配置创建限制，没有任何限制时返回 nil
+func newLimitGate(cfg conf.LimitConfig) *limitGate {
+	gate := &limitGate{}
+	if cfg.MaxInFlight > 0 {
+		gate.inFlight = make(chan struct{}, cfg.MaxInFlight)
+	}
+	if cfg.QPS > 0 {
+		burst := cfg.Burst
+		if burst <= 0 {
+			burst = int(math.Ceil(cfg.QPS))
+		}
+		gate.limiter = rate.NewLimiter(rate.Limit(cfg.QPS), burst)
+	}
+	if gate.inFlight == nil && gate.limiter == nil {
+		return nil
+	}
+	return gate
+}
+
+// acquire 等待并发令牌和速率令牌，成功时返回释放并发令牌的函数
+func (g *limitGate) acquire(ctx context.Context) (func(), error) {
+	release := func() {}
+	if g == nil {
+		return release, nil
+	}
+
+	if g.inFlight != nil {
+		select {
+		case g.inFlight <- struct{}{}:
+			release = func() { <-g.inFlight }
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if g.limiter != nil {
+		if err := g.limiter.Wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+	return release, nil
+}
+
+// targetIdleTimeout 目标的限制空闲超过该时间后被清理，此时速率令牌桶早已装满，重新创建不影响限流效果
+const targetIdleTimeout = 10 * time.Minute
+
+// targetGate 目标的限制及其使用情况
+type targetGate struct {
+	gate     *limitGate
+	users    int       // 正在等待或持有令牌的任务项数
+	lastUsed time.Time // 最近一次释放令牌的时间
+}
+
+// executorLimiter 按执行器类型和目标限制任务项的并发数和请求速率，避免巡检压垮共享的监控后端或主机
+type executorLimiter struct {
+	limits    map[string]conf.ExecutorLimitConfig
+	executors map[string]*limitGate  // 执行器类型 -> 限制
+	targets   map[string]*targetGate // 执行器类型/目标 -> 限制，只保存配置了目标限制的目标，空闲的目标定期清理
+	lastSweep time.Time
+	mutex     sync.Mutex
+}
+
+// newExecutorLimiter 创建执行器限流器
+func newExecutorLimiter(limits map[string]conf.ExecutorLimitConfig) *executorLimiter {
+	return &executorLimiter{
+		limits:    limits,
+		executors: make(map[string]*limitGate),
+		targets:   make(map[string]*targetGate),
+		lastSweep: time.Now(),
+	}
+}
+
+// gates 获取执行器类型和目标对应的限制，首次使用时按配置创建。
+// 返回的目标限制不为 nil 时记为使用中，调用方用完后需要调用 done
+func (l *executorLimiter) gates(executorType, target string) (executorGate *limitGate, entry *targetGate) {
+	cfg, exists := l.limits[executorType]
+	if !exists {
+		cfg = l.limits[defaultLimitKey]
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	executorGate, exists = l.executors[executorType]
+	if !exists {
+		executorGate = newLimitGate(cfg.LimitConfig)
+		l.executors[executorType] = executorGate
+	}
+
+	if target == "" {
+		return executorGate, nil
+	}
+	now := time.Now()
+	l.sweepLocked(now)
+
+	key := executorType + "/" + target
+	entry, exists = l.targets[key]
+	if !exists {
+		gate := newLimitGate(cfg.PerTarget)
+		if gate == nil {
+			return executorGate, nil
+		}
+		entry = &targetGate{gate: gate}
+		l.targets[key] = entry
+	}
+	entry.users++
+	entry.lastUsed = now
+	return executorGate, entry
+}
+
+// done 释放对目标限制的使用
+func (l *executorLimiter) done(entry *targetGate) {
+	if entry == nil {
+		return
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	entry.users--
+	entry.lastUsed = time.Now()
+}
+
+// sweepLocked 清理空闲超过 targetIdleTimeout 的目标限制，每个周期最多清理一次，调用方需持有锁
+func (l *executorLimiter) sweepLocked(now time.Time) {
+	if now.Sub(l.lastSweep) < targetIdleTimeout {
+		return
+	}
+	l.lastSweep = now
+	for key, entry := range l.targets {
+		if entry.users == 0 && now.Sub(entry.lastUsed) >= targetIdleTimeout {
+			delete(l.targets, key)
+		}
+	}
+}
+
+// Acquire 等待执行器和目标的限流令牌，返回释放函数和等待时间。
+// 先等待目标的令牌，避免占用执行器的并发令牌等待繁忙的目标
+func (l *executorLimiter) Acquire(ctx context.Context, executorType, target string) (func(), time.Duration, error) {
+	startTime := time.Now()
+	executorGate, entry := l.gates(executorType, target)
+	var targetLimit *limitGate
+	if entry != nil {
+		targetLimit = entry.gate
+	}
+
+	releaseTarget, err := targetLimit.acquire(ctx)
+	if err != nil {
+		l.done(entry)
+		return nil, time.Since(startTime), err
+	}
+	releaseExecutor, err := executorGate.acquire(ctx)
+	if err != nil {
+		releaseTarget()
+		l.done(entry)
+		return nil, time.Since(startTime), err
+	}
+
+	return func() {
+		releaseExecutor()
+		releaseTarget()
+		l.done(entry)
+	}, time.Since(startTime), nil
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.mokaz111.com/candy-agent/conf"
+)
+
+func TestExecutorLimiterEvictsIdleTargets(t *testing.T) {
+	l := newExecutorLimiter(map[string]conf.ExecutorLimitConfig{
+		"ssh":  {PerTarget: conf.LimitConfig{MaxInFlight: 1}},
+		"http": {LimitConfig: conf.LimitConfig{MaxInFlight: 10}},
+	})
+	ctx := context.Background()
+
+	// 没有配置目标限制的执行器不记录目标
+	for i := 0; i < 100; i++ {
+		release, _, err := l.Acquire(ctx, "http", fmt.Sprintf("host-%d", i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		release()
+	}
+	if n := len(l.targets); n != 0 {
+		t.Fatalf("targets = %d after unlimited targets, want 0", n)
+	}
+
+	idle, _, err := l.Acquire(ctx, "ssh", "idle")
+	if err != nil {
+		t.Fatal(err)
+	}
+	idle()
+	busy, _, err := l.Acquire(ctx, "ssh", "busy")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 超过空闲时间后，只清理没有任务项使用的目标
+	l.mutex.Lock()
+	for _, entry := range l.targets {
+		entry.lastUsed = entry.lastUsed.Add(-2 * targetIdleTimeout)
+	}
+	l.lastSweep = l.lastSweep.Add(-2 * targetIdleTimeout)
+	l.mutex.Unlock()
+
+	release, _, err := l.Acquire(ctx, "ssh", "new")
+	if err != nil {
+		t.Fatal(err)
+	}
+	release()
+	for key, want := range map[string]bool{"ssh/idle": false, "ssh/busy": true, "ssh/new": true} {
+		if _, exists := l.targets[key]; exists != want {
+			t.Errorf("target %s exists = %v, want %v", key, exists, want)
+		}
+	}
+
+	// 使用中的目标仍然限制并发
+	timeoutCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	if _, _, err := l.Acquire(timeoutCtx, "ssh", "busy"); err == nil {
+		t.Error("second acquire of busy target succeeded, want max_in_flight limit")
+	}
+	busy()
+	if entry := l.targets["ssh/busy"]; entry.users != 0 {
+		t.Errorf("busy target users = %d after release, want 0", entry.users)
+	}
+}
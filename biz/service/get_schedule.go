@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	candyAgent "github.mokaz111.com/candy-agent/hertz_gen/candyAgent"
+)
+
+type GetScheduleService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewGetScheduleService(Context context.Context, RequestContext *app.RequestContext) *GetScheduleService {
+	return &GetScheduleService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *GetScheduleService) Run(req *candyAgent.ScheduleRequest) (resp *candyAgent.ScheduleResponse, err error) {
+	if req.ScheduleId == "" {
+		return nil, fmt.Errorf("定时巡检ID不能为空")
+	}
+
+	schedule, err := GetScheduler().Get(req.ScheduleId)
+	if err != nil {
+		return nil, err
+	}
+
+	hlog.CtxInfof(h.Context, "Get schedule %s", schedule.ID)
+	return &candyAgent.ScheduleResponse{Schedule: convertSchedule(schedule)}, nil
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.mokaz111.com/candy-agent/biz/model"
+	candyAgent "github.mokaz111.com/candy-agent/hertz_gen/candyAgent"
+)
+
+const (
+	// defaultResultPageSize 默认每页结果数
+	defaultResultPageSize = 100
+	// maxResultPageSize 每页结果数上限
+	maxResultPageSize = 1000
+)
+
+type GetTaskResultService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewGetTaskResultService(Context context.Context, RequestContext *app.RequestContext) *GetTaskResultService {
+	return &GetTaskResultService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *GetTaskResultService) Run(req *candyAgent.TaskResultRequest) (resp *candyAgent.TaskResultResponse, err error) {
+	defer func() {
+		hlog.CtxInfof(h.Context, "req = %+v", req)
+	}()
+
+	if req.TaskId == "" {
+		return nil, fmt.Errorf("任务ID不能为空")
+	}
+
+	// 解析过滤条件
+	statusFilter := make(map[model.ResultStatus]bool, len(req.Status))
+	for _, status := range req.Status {
+		resultStatus := model.ResultStatus(status)
+		switch resultStatus {
+		case model.ResultStatusNormal, model.ResultStatusWarning, model.ResultStatusCritical, model.ResultStatusFailed, model.ResultStatusSkipped:
+			statusFilter[resultStatus] = true
+		default:
+			return nil, fmt.Errorf("不支持的结果状态: %s", status)
+		}
+	}
+	itemFilter := make(map[uint]bool, len(req.ItemId))
+	for _, itemID := range req.ItemId {
+		itemFilter[uint(itemID)] = true
+	}
+
+	// 解析分页参数
+	page, pageSize := normalizePage(int(req.Page), int(req.PageSize), defaultResultPageSize, maxResultPageSize)
+
+	// 获取任务副本，避免与正在执行的任务并发读写
+	task, err := GetTaskManager().GetTaskSnapshot(req.TaskId)
+	if err != nil {
+		return nil, fmt.Errorf("获取任务结果失败: %v", err)
+	}
+
+	// 过滤结果
+	filtered := make([]model.TaskResult, 0, len(task.Results))
+	for _, result := range task.Results {
+		if len(statusFilter) > 0 && !statusFilter[result.Status] {
+			continue
+		}
+		if len(itemFilter) > 0 && !itemFilter[result.ItemID] {
+			continue
+		}
+		filtered = append(filtered, result)
+	}
+
+	// 分页
+	start, end := pageBounds(len(filtered), page, pageSize)
+
+	resp = &candyAgent.TaskResultResponse{
+		TaskId:    task.ID,
+		Status:    convertTaskStatus(task.Status),
+		Results:   convertTaskResults(filtered[start:end]),
+		StartTime: task.StartTime.Format(time.RFC3339),
+		Message:   task.Error,
+		Total:     int32(len(filtered)),
+		Page:      int32(page),
+		PageSize:  int32(pageSize),
+	}
+	if !task.EndTime.IsZero() {
+		resp.EndTime = task.EndTime.Format(time.RFC3339)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+	candyAgent "github.mokaz111.com/candy-agent/hertz_gen/candyAgent"
+)
+
+func TestGetTaskResultFilterAndPage(t *testing.T) {
+	id := testTaskID(t)
+	statuses := []model.ResultStatus{
+		model.ResultStatusNormal, model.ResultStatusWarning, model.ResultStatusCritical,
+		model.ResultStatusWarning, model.ResultStatusNormal, model.ResultStatusWarning,
+	}
+	task := &model.Task{ID: id, Timeout: 5}
+	for i, status := range statuses {
+		task.Items = append(task.Items, fakeItem(id, uint(i+1), "item", map[string]interface{}{"status": string(status)}))
+	}
+	runTestTask(t, task)
+
+	tests := []struct {
+		name    string
+		req     *candyAgent.TaskResultRequest
+		want    []int64
+		total   int32
+		wantErr bool
+	}{
+		{name: "all", req: &candyAgent.TaskResultRequest{}, want: []int64{1, 2, 3, 4, 5, 6}, total: 6},
+		{name: "status", req: &candyAgent.TaskResultRequest{Status: []string{"warning"}}, want: []int64{2, 4, 6}, total: 3},
+		{name: "status and item", req: &candyAgent.TaskResultRequest{Status: []string{"warning", "critical"}, ItemId: []int64{3, 4}}, want: []int64{3, 4}, total: 2},
+		{name: "second page", req: &candyAgent.TaskResultRequest{Page: 2, PageSize: 4}, want: []int64{5, 6}, total: 6},
+		{name: "page past end", req: &candyAgent.TaskResultRequest{Page: 3, PageSize: 4}, want: nil, total: 6},
+		{name: "unknown status", req: &candyAgent.TaskResultRequest{Status: []string{"bad"}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.TaskId = id
+			resp, err := NewGetTaskResultService(context.Background(), nil).Run(tt.req)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Run succeeded, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Run: %v", err)
+			}
+			if resp.Total != tt.total {
+				t.Errorf("Total = %d, want %d", resp.Total, tt.total)
+			}
+			var got []int64
+			for _, result := range resp.Results {
+				got = append(got, result.ItemId)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("items = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("items = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
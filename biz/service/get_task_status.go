@@ -3,7 +3,6 @@ package service
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
@@ -31,7 +30,7 @@ func (h *GetTaskStatusService) Run(req *candyAgent.TaskStatusRequest) (resp *can
 
 	// 从任务管理器获取任务状态
 	taskManager := GetTaskManager()
-	task, err := taskManager.GetTask(req.TaskId)
+	task, err := taskManager.GetTaskSnapshot(req.TaskId)
 	if err != nil {
 		return nil, fmt.Errorf("获取任务状态失败: %v", err)
 	}
@@ -42,27 +41,7 @@ func (h *GetTaskStatusService) Run(req *candyAgent.TaskStatusRequest) (resp *can
 	}
 
 	// 将任务状态转换为响应
-	resp = &candyAgent.TaskStatusResponse{
-		TaskId:    task.ID,
-		StartTime: task.StartTime.Format(time.RFC3339),
-		Message:   task.Error,
-	}
-
-	// 将任务状态转换为枚举值
-	switch task.Status {
-	case "pending":
-		resp.Status = candyAgent.TaskStatus_TASK_STATUS_PENDING
-	case "running":
-		resp.Status = candyAgent.TaskStatus_TASK_STATUS_RUNNING
-	case "completed":
-		resp.Status = candyAgent.TaskStatus_TASK_STATUS_COMPLETED
-	case "failed":
-		resp.Status = candyAgent.TaskStatus_TASK_STATUS_FAILED
-	case "canceled":
-		resp.Status = candyAgent.TaskStatus_TASK_STATUS_CANCELED
-	default:
-		resp.Status = candyAgent.TaskStatus_TASK_STATUS_UNKNOWN
-	}
+	resp = buildTaskStatusResponse(task)
 
 	return resp, nil
 }
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	candyAgent "github.mokaz111.com/candy-agent/hertz_gen/candyAgent"
+)
+
+type GetTemplateService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewGetTemplateService(Context context.Context, RequestContext *app.RequestContext) *GetTemplateService {
+	return &GetTemplateService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *GetTemplateService) Run(req *candyAgent.TemplateRequest) (resp *candyAgent.TemplateResponse, err error) {
+	if req.Name == "" {
+		return nil, fmt.Errorf("模板名称不能为空")
+	}
+
+	template, err := GetTemplateRegistry().Get(req.Name, int(req.Version))
+	if err != nil {
+		return nil, err
+	}
+
+	hlog.CtxInfof(h.Context, "Got template %s@v%d", template.Name, template.Version)
+	return &candyAgent.TemplateResponse{Template: convertTemplate(template)}, nil
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	candyAgent "github.mokaz111.com/candy-agent/hertz_gen/candyAgent"
+)
+
+type ListCallbacksService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewListCallbacksService(Context context.Context, RequestContext *app.RequestContext) *ListCallbacksService {
+	return &ListCallbacksService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *ListCallbacksService) Run(req *candyAgent.Empty) (resp *candyAgent.CallbackListResponse, err error) {
+	records := GetCallbackOutbox().List()
+
+	resp = &candyAgent.CallbackListResponse{
+		Callbacks: make([]*candyAgent.CallbackRecord, 0, len(records)),
+		Total:     int32(len(records)),
+	}
+	for i := range records {
+		resp.Callbacks = append(resp.Callbacks, convertCallbackRecord(&records[i]))
+	}
+
+	hlog.CtxInfof(h.Context, "Listed %d pending callbacks", len(records))
+	return resp, nil
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.mokaz111.com/candy-agent/biz/executor"
+	candyAgent "github.mokaz111.com/candy-agent/hertz_gen/candyAgent"
+)
+
+type ListExecutorsService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewListExecutorsService(Context context.Context, RequestContext *app.RequestContext) *ListExecutorsService {
+	return &ListExecutorsService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *ListExecutorsService) Run(req *candyAgent.Empty) (resp *candyAgent.ExecutorListResponse, err error) {
+	schemas := executor.GetExecutorFactory().Schemas()
+
+	resp = &candyAgent.ExecutorListResponse{
+		Executors: make([]*candyAgent.ExecutorInfo, 0, len(schemas)),
+		Total:     int32(len(schemas)),
+	}
+	for _, schema := range schemas {
+		resp.Executors = append(resp.Executors, convertExecutorSchema(schema))
+	}
+
+	hlog.CtxInfof(h.Context, "Listed %d executors", len(schemas))
+	return resp, nil
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	candyAgent "github.mokaz111.com/candy-agent/hertz_gen/candyAgent"
+)
+
+type ListSchedulesService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewListSchedulesService(Context context.Context, RequestContext *app.RequestContext) *ListSchedulesService {
+	return &ListSchedulesService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *ListSchedulesService) Run(req *candyAgent.Empty) (resp *candyAgent.ScheduleListResponse, err error) {
+	schedules := GetScheduler().List()
+
+	resp = &candyAgent.ScheduleListResponse{
+		Schedules: make([]*candyAgent.Schedule, 0, len(schedules)),
+		Total:     int32(len(schedules)),
+	}
+	for i := range schedules {
+		resp.Schedules = append(resp.Schedules, convertSchedule(&schedules[i]))
+	}
+
+	hlog.CtxInfof(h.Context, "Listed %d schedules", len(schedules))
+	return resp, nil
+}
@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.mokaz111.com/candy-agent/biz/model"
+	candyAgent "github.mokaz111.com/candy-agent/hertz_gen/candyAgent"
+)
+
+const (
+	// defaultTaskPageSize 默认每页任务数
+	defaultTaskPageSize = 20
+	// maxTaskPageSize 每页任务数上限
+	maxTaskPageSize = 500
+)
+
+// ErrInvalidTaskQuery 任务列表的过滤、排序条件无效
+var ErrInvalidTaskQuery = errors.New("任务列表查询条件无效")
+
+type ListTasksService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewListTasksService(Context context.Context, RequestContext *app.RequestContext) *ListTasksService {
+	return &ListTasksService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *ListTasksService) Run(req *candyAgent.TaskListRequest) (resp *candyAgent.TaskListResponse, err error) {
+	defer func() {
+		hlog.CtxInfof(h.Context, "req = %+v", req)
+	}()
+
+	return listTasks(GetTaskManager().ListTasks(), req, time.Now())
+}
+
+// listTasks 按请求过滤、排序并分页任务，统计每个任务的结果状态
+func listTasks(tasks []model.Task, req *candyAgent.TaskListRequest, now time.Time) (*candyAgent.TaskListResponse, error) {
+	filter, err := newTaskFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// 排序字段和方向
+	sortBy := req.SortBy
+	if sortBy == "" {
+		sortBy = "start_time"
+	}
+	switch sortBy {
+	case "start_time", "end_time", "duration", "priority":
+	default:
+		return nil, fmt.Errorf("%w: 不支持的排序字段 %s", ErrInvalidTaskQuery, req.SortBy)
+	}
+	var ascending bool
+	switch req.Order {
+	case "", "desc":
+	case "asc":
+		ascending = true
+	default:
+		return nil, fmt.Errorf("%w: 不支持的排序方向 %s", ErrInvalidTaskQuery, req.Order)
+	}
+
+	page, pageSize := normalizePage(int(req.Page), int(req.PageSize), defaultTaskPageSize, maxTaskPageSize)
+
+	// 过滤任务
+	filtered := make([]model.Task, 0, len(tasks))
+	for _, task := range tasks {
+		if filter.match(&task) {
+			filtered = append(filtered, task)
+		}
+	}
+
+	// 排序，值相同时按任务ID排序保证分页稳定
+	sort.Slice(filtered, func(i, j int) bool {
+		a, b := &filtered[i], &filtered[j]
+		var less, equal bool
+		switch sortBy {
+		case "end_time":
+			less, equal = a.EndTime.Before(b.EndTime), a.EndTime.Equal(b.EndTime)
+		case "duration":
+			da, db := taskDuration(a, now), taskDuration(b, now)
+			less, equal = da < db, da == db
+		case "priority":
+			less, equal = a.Priority < b.Priority, a.Priority == b.Priority
+		default:
+			less, equal = a.StartTime.Before(b.StartTime), a.StartTime.Equal(b.StartTime)
+		}
+		if equal {
+			return a.ID < b.ID
+		}
+		if ascending {
+			return less
+		}
+		return !less
+	})
+
+	start, end := pageBounds(len(filtered), page, pageSize)
+	resp := &candyAgent.TaskListResponse{
+		Tasks:    make([]*candyAgent.TaskSummary, 0, end-start),
+		Total:    int32(len(filtered)),
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	}
+	for i := start; i < end; i++ {
+		resp.Tasks = append(resp.Tasks, buildTaskSummary(&filtered[i], now))
+	}
+
+	return resp, nil
+}
+
+// taskFilter 任务列表过滤条件
+type taskFilter struct {
+	statuses map[model.TaskStatus]bool
+	from     time.Time
+	to       time.Time
+	itemType string
+	name     string
+}
+
+// newTaskFilter 解析并校验过滤条件
+func newTaskFilter(req *candyAgent.TaskListRequest) (*taskFilter, error) {
+	filter := &taskFilter{
+		statuses: make(map[model.TaskStatus]bool, len(req.Status)),
+		itemType: req.ItemType,
+		name:     strings.ToLower(req.Name),
+	}
+
+	for _, status := range req.Status {
+		taskStatus := model.TaskStatus(status)
+		switch taskStatus {
+		case model.TaskStatusPending, model.TaskStatusRunning, model.TaskStatusCompleted, model.TaskStatusFailed, model.TaskStatusCanceled:
+			filter.statuses[taskStatus] = true
+		default:
+			return nil, fmt.Errorf("%w: 不支持的任务状态 %s", ErrInvalidTaskQuery, status)
+		}
+	}
+
+	var err error
+	if req.StartTimeFrom != "" {
+		if filter.from, err = time.Parse(time.RFC3339, req.StartTimeFrom); err != nil {
+			return nil, fmt.Errorf("%w: start_time_from 格式错误，需要 RFC3339 格式: %v", ErrInvalidTaskQuery, err)
+		}
+	}
+	if req.StartTimeTo != "" {
+		if filter.to, err = time.Parse(time.RFC3339, req.StartTimeTo); err != nil {
+			return nil, fmt.Errorf("%w: start_time_to 格式错误，需要 RFC3339 格式: %v", ErrInvalidTaskQuery, err)
+		}
+	}
+	if !filter.from.IsZero() && !filter.to.IsZero() && filter.from.After(filter.to) {
+		return nil, fmt.Errorf("%w: start_time_from 不能晚于 start_time_to", ErrInvalidTaskQuery)
+	}
+	return filter, nil
+}
+
+// match 判断任务是否满足所有过滤条件
+func (f *taskFilter) match(task *model.Task) bool {
+	if len(f.statuses) > 0 && !f.statuses[task.Status] {
+		return false
+	}
+	if !f.from.IsZero() && task.StartTime.Before(f.from) {
+		return false
+	}
+	if !f.to.IsZero() && task.StartTime.After(f.to) {
+		return false
+	}
+	if f.itemType != "" && !taskHasItem(task, func(item model.TaskItem) bool { return item.Type == f.itemType }) {
+		return false
+	}
+	if f.name != "" &&
+		!strings.Contains(strings.ToLower(task.ID), f.name) &&
+		!strings.Contains(strings.ToLower(task.ScheduleID), f.name) &&
+		!taskHasItem(task, func(item model.TaskItem) bool { return strings.Contains(strings.ToLower(item.Name), f.name) }) {
+		return false
+	}
+	return true
+}
+
+// taskHasItem 判断任务中是否有满足条件的任务项
+func taskHasItem(task *model.Task, match func(item model.TaskItem) bool) bool {
+	for _, item := range task.Items {
+		if match(item) {
+			return true
+		}
+	}
+	return false
+}
+
+// taskDuration 任务耗时，未结束的任务为已运行时间
+func taskDuration(task *model.Task, now time.Time) time.Duration {
+	if task.EndTime.IsZero() {
+		return now.Sub(task.StartTime)
+	}
+	return task.EndTime.Sub(task.StartTime)
+}
+
+// buildTaskSummary 构建任务摘要，统计各结果状态的任务项数
+func buildTaskSummary(task *model.Task, now time.Time) *candyAgent.TaskSummary {
+	summary := &candyAgent.TaskSummary{
+		TaskId:         task.ID,
+		Status:         convertTaskStatus(task.Status),
+		StartTime:      task.StartTime.Format(time.RFC3339),
+		Duration:       taskDuration(task, now).Milliseconds(),
+		Priority:       int32(task.Priority),
+		ScheduleId:     task.ScheduleID,
+		TotalItems:     int32(len(task.Items)),
+		CompletedItems: int32(len(task.Results)),
+		ResultCounts:   make(map[string]int32),
+		Interrupted:    task.Interrupted,
+		Message:        task.Error,
+	}
+	if !task.EndTime.IsZero() {
+		summary.EndTime = task.EndTime.Format(time.RFC3339)
+	}
+	for _, result := range task.Results {
+		summary.ResultCounts[string(result.Status)]++
+	}
+	health := summarizeTaskHealth(task)
+	summary.HealthScore = health.Score
+	summary.WorstStatus = string(health.WorstStatus)
+	return summary
+}
@@ -0,0 +1,184 @@
+package service
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+	candyAgent "github.mokaz111.com/candy-agent/hertz_gen/candyAgent"
+)
+
+func listTestTasks(now time.Time) []model.Task {
+	at := func(minutes int) time.Time { return now.Add(time.Duration(minutes) * time.Minute) }
+	return []model.Task{
+		{
+			ID: "task-a", Status: model.TaskStatusCompleted, Priority: 1,
+			StartTime: at(-60), EndTime: at(-50),
+			Items: []model.TaskItem{{ID: 1, Name: "disk usage", Type: "prometheus"}, {ID: 2, Name: "uptime", Type: "ssh"}},
+			Results: []model.TaskResult{
+				{ItemID: 1, Status: model.ResultStatusWarning},
+				{ItemID: 2, Status: model.ResultStatusNormal},
+			},
+		},
+		{
+			ID: "task-b", Status: model.TaskStatusFailed, Priority: 5, ScheduleID: "nightly",
+			StartTime: at(-40), EndTime: at(-39),
+			Items:   []model.TaskItem{{ID: 1, Name: "api health", Type: "http"}},
+			Results: []model.TaskResult{{ItemID: 1, Status: model.ResultStatusFailed}},
+		},
+		{
+			ID: "task-c", Status: model.TaskStatusRunning, Priority: 3,
+			StartTime: at(-20),
+			Items:     []model.TaskItem{{ID: 1, Name: "node cpu", Type: "prometheus"}, {ID: 2, Name: "pods", Type: "kubernetes"}},
+			Results:   []model.TaskResult{{ItemID: 1, Status: model.ResultStatusCritical}},
+		},
+		{
+			ID: "task-d", Status: model.TaskStatusCompleted, Priority: 3,
+			StartTime: at(-10), EndTime: at(-5),
+			Items:   []model.TaskItem{{ID: 1, Name: "Disk inode", Type: "ssh"}},
+			Results: []model.TaskResult{{ItemID: 1, Status: model.ResultStatusNormal}},
+		},
+	}
+}
+
+func taskIDs(resp *candyAgent.TaskListResponse) []string {
+	ids := make([]string, 0, len(resp.Tasks))
+	for _, task := range resp.Tasks {
+		ids = append(ids, task.TaskId)
+	}
+	return ids
+}
+
+func TestListTasks(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	tasks := listTestTasks(now)
+
+	tests := []struct {
+		name      string
+		req       *candyAgent.TaskListRequest
+		want      []string
+		wantTotal int32
+	}{
+		{name: "default newest first", req: &candyAgent.TaskListRequest{}, want: []string{"task-d", "task-c", "task-b", "task-a"}},
+		{name: "status", req: &candyAgent.TaskListRequest{Status: []string{"completed", "failed"}}, want: []string{"task-d", "task-b", "task-a"}},
+		{
+			name: "time range is inclusive",
+			req: &candyAgent.TaskListRequest{
+				StartTimeFrom: now.Add(-40 * time.Minute).Format(time.RFC3339),
+				StartTimeTo:   now.Add(-20 * time.Minute).Format(time.RFC3339),
+			},
+			want: []string{"task-c", "task-b"},
+		},
+		{name: "time from only", req: &candyAgent.TaskListRequest{StartTimeFrom: now.Add(-15 * time.Minute).Format(time.RFC3339)}, want: []string{"task-d"}},
+		{name: "item type", req: &candyAgent.TaskListRequest{ItemType: "prometheus"}, want: []string{"task-c", "task-a"}},
+		{name: "name matches item case insensitive", req: &candyAgent.TaskListRequest{Name: "DISK"}, want: []string{"task-d", "task-a"}},
+		{name: "name matches task id", req: &candyAgent.TaskListRequest{Name: "task-b"}, want: []string{"task-b"}},
+		{name: "name matches schedule id", req: &candyAgent.TaskListRequest{Name: "night"}, want: []string{"task-b"}},
+		{name: "filters combine", req: &candyAgent.TaskListRequest{Status: []string{"completed"}, ItemType: "ssh", Name: "uptime"}, want: []string{"task-a"}},
+		{name: "start time asc", req: &candyAgent.TaskListRequest{Order: "asc"}, want: []string{"task-a", "task-b", "task-c", "task-d"}},
+		{name: "end time desc", req: &candyAgent.TaskListRequest{SortBy: "end_time"}, want: []string{"task-d", "task-b", "task-a", "task-c"}},
+		{name: "duration desc counts running time", req: &candyAgent.TaskListRequest{SortBy: "duration"}, want: []string{"task-c", "task-a", "task-d", "task-b"}},
+		{name: "priority ties by id", req: &candyAgent.TaskListRequest{SortBy: "priority", Order: "asc"}, want: []string{"task-a", "task-c", "task-d", "task-b"}},
+		{name: "first page", req: &candyAgent.TaskListRequest{Page: 1, PageSize: 3}, want: []string{"task-d", "task-c", "task-b"}, wantTotal: 4},
+		{name: "last partial page", req: &candyAgent.TaskListRequest{Page: 2, PageSize: 3}, want: []string{"task-a"}, wantTotal: 4},
+		{name: "page past end", req: &candyAgent.TaskListRequest{Page: 3, PageSize: 3}, want: []string{}, wantTotal: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := listTasks(tasks, tt.req, now)
+			if err != nil {
+				t.Fatalf("listTasks() error = %v", err)
+			}
+			if got := taskIDs(resp); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("tasks = %v, want %v", got, tt.want)
+			}
+			wantTotal := tt.wantTotal
+			if wantTotal == 0 {
+				wantTotal = int32(len(tt.want))
+			}
+			if resp.Total != wantTotal {
+				t.Errorf("total = %d, want %d", resp.Total, wantTotal)
+			}
+		})
+	}
+}
+
+func TestListTasksPageBounds(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name         string
+		page         int32
+		pageSize     int32
+		wantPage     int32
+		wantPageSize int32
+	}{
+		{name: "defaults", wantPage: 1, wantPageSize: defaultTaskPageSize},
+		{name: "negative", page: -1, pageSize: -5, wantPage: 1, wantPageSize: defaultTaskPageSize},
+		{name: "capped", page: 2, pageSize: maxTaskPageSize + 1, wantPage: 2, wantPageSize: maxTaskPageSize},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := listTasks(listTestTasks(now), &candyAgent.TaskListRequest{Page: tt.page, PageSize: tt.pageSize}, now)
+			if err != nil {
+				t.Fatalf("listTasks() error = %v", err)
+			}
+			if resp.Page != tt.wantPage || resp.PageSize != tt.wantPageSize {
+				t.Errorf("page = %d/%d, want %d/%d", resp.Page, resp.PageSize, tt.wantPage, tt.wantPageSize)
+			}
+		})
+	}
+}
+
+func TestListTasksSummaryCounts(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	resp, err := listTasks(listTestTasks(now), &candyAgent.TaskListRequest{Name: "task-"}, now)
+	if err != nil {
+		t.Fatalf("listTasks() error = %v", err)
+	}
+
+	want := map[string]struct {
+		counts           map[string]int32
+		total, completed int32
+	}{
+		"task-a": {counts: map[string]int32{"warning": 1, "normal": 1}, total: 2, completed: 2},
+		"task-b": {counts: map[string]int32{"failed": 1}, total: 1, completed: 1},
+		"task-c": {counts: map[string]int32{"critical": 1}, total: 2, completed: 1},
+		"task-d": {counts: map[string]int32{"normal": 1}, total: 1, completed: 1},
+	}
+	for _, summary := range resp.Tasks {
+		w := want[summary.TaskId]
+		if !reflect.DeepEqual(summary.ResultCounts, w.counts) {
+			t.Errorf("%s result counts = %v, want %v", summary.TaskId, summary.ResultCounts, w.counts)
+		}
+		if summary.TotalItems != w.total || summary.CompletedItems != w.completed {
+			t.Errorf("%s items = %d/%d, want %d/%d", summary.TaskId, summary.CompletedItems, summary.TotalItems, w.completed, w.total)
+		}
+	}
+	if running := resp.Tasks[1]; running.TaskId != "task-c" || running.EndTime != "" || running.Duration != (20*time.Minute).Milliseconds() {
+		t.Errorf("running task summary = %+v, want no end time and 20m duration", running)
+	}
+}
+
+func TestListTasksInvalidQuery(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name string
+		req  *candyAgent.TaskListRequest
+	}{
+		{name: "unknown status", req: &candyAgent.TaskListRequest{Status: []string{"done"}}},
+		{name: "bad time format", req: &candyAgent.TaskListRequest{StartTimeFrom: "2026-01-01"}},
+		{name: "from after to", req: &candyAgent.TaskListRequest{StartTimeFrom: "2026-01-02T00:00:00Z", StartTimeTo: "2026-01-01T00:00:00Z"}},
+		{name: "unknown sort field", req: &candyAgent.TaskListRequest{SortBy: "name"}},
+		{name: "unknown order", req: &candyAgent.TaskListRequest{Order: "up"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := listTasks(listTestTasks(now), tt.req, now)
+			if !errors.Is(err, ErrInvalidTaskQuery) {
+				t.Fatalf("listTasks() error = %v, want ErrInvalidTaskQuery", err)
+			}
+		})
+	}
+}
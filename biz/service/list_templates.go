@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	candyAgent "github.mokaz111.com/candy-agent/hertz_gen/candyAgent"
+)
+
+type ListTemplatesService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewListTemplatesService(Context context.Context, RequestContext *app.RequestContext) *ListTemplatesService {
+	return &ListTemplatesService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *ListTemplatesService) Run(req *candyAgent.Empty) (resp *candyAgent.TemplateListResponse, err error) {
+	templates := GetTemplateRegistry().List()
+
+	resp = &candyAgent.TemplateListResponse{
+		Templates: make([]*candyAgent.Template, 0, len(templates)),
+		Total:     int32(len(templates)),
+	}
+	for i := range templates {
+		resp.Templates = append(resp.Templates, convertTemplate(&templates[i]))
+	}
+
+	hlog.CtxInfof(h.Context, "Listed %d templates", len(templates))
+	return resp, nil
+}
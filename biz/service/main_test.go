@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.mokaz111.com/candy-agent/biz/executor"
+	"github.mokaz111.com/candy-agent/biz/model"
+)
+
+// testConf 测试使用的配置，所有存储使用内存，不配置回调地址
+const testConf = `
+task_manager:
+  max_workers: 4
+  max_queue_depth: 100
+  item_parallelism: 5
+  task_store: memory
+callback:
+  store: memory
+scheduler:
+  store: memory
+template:
+  store: memory
+history:
+  limit: 3
+  store: memory
+`
+
+// TestMain 在临时目录中写入测试配置后运行测试，conf.GetConf 从工作目录读取配置
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "candy-agent-service-test")
+	if err != nil {
+		panic(err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "conf", "test"), 0755); err != nil {
+		panic(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "conf", "test", "conf.yaml"), []byte(testConf), 0644); err != nil {
+		panic(err)
+	}
+	os.Setenv("GO_ENV", "test")
+	if err := os.Chdir(dir); err != nil {
+		panic(err)
+	}
+
+	executor.GetExecutorFactory().Register(fakeExecutor{})
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+// fakeExecutor 测试执行器，按任务项参数返回结果：
+// status 为结果状态，value 为结果值，labels 为结果标签，error 不为空时返回执行错误，
+// block 为 true 时阻塞到 ctx 结束
+type fakeExecutor struct{}
+
+func (fakeExecutor) Name() string { return "fake" }
+
+func (fakeExecutor) Schema() executor.Schema {
+	return executor.Schema{Name: "fake", Params: []executor.ParamSchema{
+		{Name: "status", Type: executor.ParamTypeString},
+		{Name: "value", Type: executor.ParamTypeString},
+	}}
+}
+
+func (fakeExecutor) Execute(ctx context.Context, item model.TaskItem) (model.TaskResult, error) {
+	fakeCalls.record(item.Name)
+	if block, _ := item.Params["block"].(bool); block {
+		<-ctx.Done()
+		return model.TaskResult{ItemID: item.ID, Status: model.ResultStatusFailed}, ctx.Err()
+	}
+	if msg, _ := item.Params["error"].(string); msg != "" {
+		return model.TaskResult{}, fmt.Errorf("%s", msg)
+	}
+	status, _ := item.Params["status"].(string)
+	if status == "" {
+		status = string(model.ResultStatusNormal)
+	}
+	result := model.TaskResult{ItemID: item.ID, Status: model.ResultStatus(status), Message: "fake"}
+	result.Value, _ = item.Params["value"].(string)
+	if labels, ok := item.Params["labels"].(map[string]string); ok {
+		result.Labels = labels
+	}
+	return result, nil
+}
+
+// fakeCallCounter 按任务项名称记录 fakeExecutor 的执行次数
+type fakeCallCounter struct {
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+var fakeCalls = &fakeCallCounter{calls: make(map[string]int)}
+
+func (c *fakeCallCounter) record(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls[name]++
+}
+
+func (c *fakeCallCounter) count(taskID, name string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.calls[taskID+"/"+name]
+}
+
+// fakeItem 创建使用 fakeExecutor 的任务项，名称带上任务ID，便于按任务统计执行次数
+func fakeItem(taskID string, id uint, name string, params map[string]interface{}) model.TaskItem {
+	return model.TaskItem{ID: id, Name: taskID + "/" + name, Type: "fake", Params: params}
+}
+
+var testTaskSeq atomic.Int64
+
+// testTaskID 生成测试任务ID，任务管理器在测试间共享，重复运行测试时ID也不能重复
+func testTaskID(t *testing.T) string {
+	return fmt.Sprintf("%s-%d", t.Name(), testTaskSeq.Add(1))
+}
+
+// runTestTask 创建任务并等待执行结束，返回结束时的任务副本
+func runTestTask(t *testing.T, task *model.Task) *model.Task {
+	t.Helper()
+	tm := GetTaskManager()
+	if _, err := tm.CreateTask(task); err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	snapshot, err := tm.WaitTask(context.Background(), task.ID)
+	if err != nil {
+		t.Fatalf("WaitTask: %v", err)
+	}
+	return snapshot
+}
+
+// resultOf 按任务项ID查找结果
+func resultOf(task *model.Task, itemID uint) *model.TaskResult {
+	for i := range task.Results {
+		if task.Results[i].ItemID == itemID {
+			return &task.Results[i]
+		}
+	}
+	return nil
+}
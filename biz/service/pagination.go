@@ -0,0 +1,28 @@
+package service
+
+// normalizePage 填充分页参数默认值，page 从1开始，pageSize 不超过 maxPageSize
+func normalizePage(page, pageSize, defaultPageSize, maxPageSize int) (int, int) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	return page, pageSize
+}
+
+// pageBounds 计算分页在列表中的起止下标
+func pageBounds(total, page, pageSize int) (int, int) {
+	start := (page - 1) * pageSize
+	if start > total {
+		start = total
+	}
+	end := start + pageSize
+	if end > total {
+		end = total
+	}
+	return start, end
+}
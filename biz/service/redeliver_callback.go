@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	candyAgent "github.mokaz111.com/candy-agent/hertz_gen/candyAgent"
+)
+
+type RedeliverCallbackService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewRedeliverCallbackService(Context context.Context, RequestContext *app.RequestContext) *RedeliverCallbackService {
+	return &RedeliverCallbackService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *RedeliverCallbackService) Run(req *candyAgent.CallbackRedeliverRequest) (resp *candyAgent.CallbackRedeliverResponse, err error) {
+	if req.TaskId == "" {
+		return nil, fmt.Errorf("任务ID不能为空")
+	}
+
+	hlog.CtxInfof(h.Context, "Redelivering callback for task %s", req.TaskId)
+
+	record, deliverErr := GetCallbackOutbox().Redeliver(req.TaskId)
+	if deliverErr != nil && record == nil {
+		// 回调不存在或正在投递中
+		return nil, deliverErr
+	}
+
+	resp = &candyAgent.CallbackRedeliverResponse{
+		TaskId:    req.TaskId,
+		Delivered: deliverErr == nil,
+		Message:   "回调投递成功",
+	}
+	if deliverErr != nil {
+		resp.Message = fmt.Sprintf("回调投递失败: %v", deliverErr)
+	}
+	if record != nil {
+		resp.Callback = convertCallbackRecord(record)
+	}
+	return resp, nil
+}
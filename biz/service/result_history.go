@@ -0,0 +1,213 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.mokaz111.com/candy-agent/biz/model"
+	"github.mokaz111.com/candy-agent/conf"
+)
+
+// defaultHistoryLimit 每个巡检项默认保留的历史结果数
+const defaultHistoryLimit = 10
+
+// ResultHistoryManager 巡检结果历史，保存每个巡检项最近的结果，用于判断结果相对上一次执行的变化
+type ResultHistoryManager struct {
+	store     model.ResultHistoryStore
+	limit     int
+	histories map[string]*model.ResultHistory // 巡检项标识 -> 结果历史
+	versions  map[string]*historyVersion      // 巡检项标识 -> 修改版本，用于丢弃过时的持久化
+	mutex     sync.Mutex
+}
+
+// historyVersion 巡检项结果历史的修改版本和已持久化的版本
+type historyVersion struct {
+	mutex   sync.Mutex // 串行化同一巡检项的持久化
+	current uint64     // 最近一次修改的版本，由 ResultHistoryManager 的锁保护
+	saved   uint64     // 已持久化的版本，由 mutex 保护
+}
+
+var (
+	resultHistoryInstance *ResultHistoryManager
+	resultHistoryOnce     sync.Once
+)
+
+// GetResultHistory 获取巡检结果历史单例，首次调用时加载存储中的结果历史
+func GetResultHistory() *ResultHistoryManager {
+	resultHistoryOnce.Do(func() {
+		cfg := conf.GetConf().History
+		limit := cfg.Limit
+		if limit <= 0 {
+			limit = defaultHistoryLimit
+		}
+		resultHistoryInstance = &ResultHistoryManager{
+			store:     newResultHistoryStore(),
+			limit:     limit,
+			histories: make(map[string]*model.ResultHistory),
+			versions:  make(map[string]*historyVersion),
+		}
+		resultHistoryInstance.load()
+	})
+	return resultHistoryInstance
+}
+
+// newResultHistoryStore 根据配置创建结果历史存储，每个巡检项保存为一个对象
+func newResultHistoryStore() model.ResultHistoryStore {
+	cfg := conf.GetConf().History
+	return newStore(storeConfig{name: "结果历史", kind: cfg.Store, dir: cfg.StoreDir, defaultDir: "data/history"},
+		func(history *model.ResultHistory) string { return history.Key })
+}
+
+// load 加载存储中的结果历史
+func (h *ResultHistoryManager) load() {
+	histories, err := h.store.LoadAll()
+	if err != nil {
+		hlog.Errorf("加载结果历史失败: %v", err)
+		return
+	}
+	for _, history := range histories {
+		h.histories[history.Key] = history
+	}
+	if len(histories) > 0 {
+		hlog.Infof("已加载 %d 个巡检项的结果历史", len(histories))
+	}
+}
+
+// resultHistoryKey 巡检项标识，未设置 history_key 时按执行器类型和参数的摘要区分
+func resultHistoryKey(item model.TaskItem) string {
+	if item.HistoryKey != "" {
+		return item.HistoryKey
+	}
+	data, _ := json.Marshal(item.Params)
+	sum := sha256.Sum256(append([]byte(item.Type+"\n"), data...))
+	return item.Type + "-" + hex.EncodeToString(sum[:8])
+}
+
+// Annotate 将结果与同一巡检项的上一次结果比较，标注变化和差值，并把结果记入历史。
+// 结果历史的副本在锁外持久化，避免写文件时阻塞其他任务项
+func (h *ResultHistoryManager) Annotate(taskID string, item model.TaskItem, result *model.TaskResult) {
+	key := resultHistoryKey(item)
+
+	h.mutex.Lock()
+	history, exists := h.histories[key]
+	if !exists {
+		history = &model.ResultHistory{Key: key}
+		h.histories[key] = history
+	}
+	if n := len(history.Entries); n > 0 {
+		annotateTransition(history.Entries[n-1], result)
+	} else if isAbnormalStatus(result.Status) {
+		result.Transition = model.TransitionNew
+	}
+
+	history.Type = item.Type
+	history.Name = item.Name
+	history.Entries = append(history.Entries, model.ResultHistoryEntry{
+		TaskID: taskID,
+		Status: result.Status,
+		Value:  result.Value,
+		Time:   time.Now(),
+	})
+	if len(history.Entries) > h.limit {
+		history.Entries = append([]model.ResultHistoryEntry(nil), history.Entries[len(history.Entries)-h.limit:]...)
+	}
+
+	version, exists := h.versions[key]
+	if !exists {
+		version = &historyVersion{}
+		h.versions[key] = version
+	}
+	version.current++
+	current := version.current
+	snapshot := *history
+	snapshot.Entries = append([]model.ResultHistoryEntry(nil), history.Entries...)
+	h.mutex.Unlock()
+
+	h.persist(&snapshot, version, current)
+}
+
+// persist 保存结果历史的副本，已保存更新的版本时丢弃，避免并发保存时旧版本覆盖新版本
+func (h *ResultHistoryManager) persist(history *model.ResultHistory, version *historyVersion, current uint64) {
+	version.mutex.Lock()
+	defer version.mutex.Unlock()
+
+	if current <= version.saved {
+		return
+	}
+	if err := h.store.Save(history); err != nil {
+		hlog.Errorf("保存巡检项 %s 的结果历史失败: %v", history.Key, err)
+		return
+	}
+	version.saved = current
+}
+
+// annotateTransition 根据上一次结果标注结果的变化和差值
+func annotateTransition(previous model.ResultHistoryEntry, result *model.TaskResult) {
+	result.PreviousStatus = previous.Status
+	result.PreviousValue = previous.Value
+
+	current, currentErr := strconv.ParseFloat(strings.TrimSpace(result.Value), 64)
+	last, lastErr := strconv.ParseFloat(strings.TrimSpace(previous.Value), 64)
+	if currentErr == nil && lastErr == nil {
+		delta := current - last
+		result.Delta = &delta
+	}
+
+	wasAbnormal, isAbnormal := isAbnormalStatus(previous.Status), isAbnormalStatus(result.Status)
+	switch {
+	case isAbnormal && !wasAbnormal:
+		result.Transition = model.TransitionNew
+	case !isAbnormal && wasAbnormal:
+		result.Transition = model.TransitionResolved
+	case isAbnormal && previous.Status == result.Status:
+		result.Transition = model.TransitionPersisting
+	case isAbnormal || previous.Value != result.Value:
+		result.Transition = model.TransitionChanged
+	default:
+		result.Transition = model.TransitionUnchanged
+	}
+}
+
+// isAbnormalStatus 结果状态是否为异常
+func isAbnormalStatus(status model.ResultStatus) bool {
+	switch status {
+	case model.ResultStatusWarning, model.ResultStatusCritical, model.ResultStatusFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// collectResultChanges 收集相对上一次执行新出现、已恢复或发生变化的任务项
+func collectResultChanges(task *model.Task) []model.ResultChange {
+	names := make(map[uint]string, len(task.Items))
+	for _, item := range task.Items {
+		names[item.ID] = item.Name
+	}
+
+	var changes []model.ResultChange
+	for _, result := range task.Results {
+		switch result.Transition {
+		case model.TransitionNew, model.TransitionResolved, model.TransitionChanged:
+		default:
+			continue
+		}
+		changes = append(changes, model.ResultChange{
+			ItemID:         result.ItemID,
+			Name:           names[result.ItemID],
+			Transition:     result.Transition,
+			Status:         result.Status,
+			PreviousStatus: result.PreviousStatus,
+			Value:          result.Value,
+			PreviousValue:  result.PreviousValue,
+			Delta:          result.Delta,
+		})
+	}
+	return changes
+}
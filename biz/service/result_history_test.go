@@ -0,0 +1,125 @@
+package service
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+)
+
+// blockingHistoryStore 保存巡检项 block 的结果历史时阻塞到 release 关闭，记录每个巡检项最后保存的条目数
+type blockingHistoryStore struct {
+	block   string
+	release chan struct{}
+	mu      sync.Mutex
+	saved   map[string]int
+}
+
+func (s *blockingHistoryStore) Save(history *model.ResultHistory) error {
+	if history.Key == s.block {
+		<-s.release
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.saved[history.Key] = len(history.Entries)
+	return nil
+}
+
+func (s *blockingHistoryStore) Delete(id string) error { return nil }
+
+func (s *blockingHistoryStore) LoadAll() ([]*model.ResultHistory, error) { return nil, nil }
+
+func newTestResultHistory(store model.ResultHistoryStore) *ResultHistoryManager {
+	return &ResultHistoryManager{
+		store:     store,
+		limit:     3,
+		histories: make(map[string]*model.ResultHistory),
+		versions:  make(map[string]*historyVersion),
+	}
+}
+
+func TestResultHistoryAnnotate(t *testing.T) {
+	h := newTestResultHistory(model.NewMemoryStore[model.ResultHistory]())
+	item := model.TaskItem{Type: "fake", HistoryKey: "disk"}
+
+	steps := []struct {
+		status         model.ResultStatus
+		value          string
+		wantTransition model.ResultTransition
+		wantDelta      *float64
+	}{
+		{status: model.ResultStatusNormal, value: "50"},
+		{status: model.ResultStatusNormal, value: "50", wantTransition: model.TransitionUnchanged, wantDelta: float64Ptr(0)},
+		{status: model.ResultStatusWarning, value: "85", wantTransition: model.TransitionNew, wantDelta: float64Ptr(35)},
+		{status: model.ResultStatusWarning, value: "88", wantTransition: model.TransitionPersisting, wantDelta: float64Ptr(3)},
+		{status: model.ResultStatusCritical, value: "n/a", wantTransition: model.TransitionChanged},
+		{status: model.ResultStatusNormal, value: "40", wantTransition: model.TransitionResolved},
+	}
+	for i, step := range steps {
+		result := model.TaskResult{Status: step.status, Value: step.value}
+		h.Annotate("task", item, &result)
+		if result.Transition != step.wantTransition {
+			t.Errorf("step %d transition = %q, want %q", i, result.Transition, step.wantTransition)
+		}
+		switch {
+		case step.wantDelta == nil && result.Delta != nil:
+			t.Errorf("step %d delta = %v, want nil", i, *result.Delta)
+		case step.wantDelta != nil && (result.Delta == nil || *result.Delta != *step.wantDelta):
+			t.Errorf("step %d delta = %v, want %v", i, result.Delta, *step.wantDelta)
+		}
+	}
+	if n := len(h.histories["disk"].Entries); n != h.limit {
+		t.Errorf("history entries = %d, want %d", n, h.limit)
+	}
+}
+
+func TestResultHistorySavesOutsideLock(t *testing.T) {
+	store := &blockingHistoryStore{block: "slow", release: make(chan struct{}), saved: make(map[string]int)}
+	h := newTestResultHistory(store)
+
+	slow := make(chan struct{})
+	go func() {
+		h.Annotate("task", model.TaskItem{Type: "fake", HistoryKey: "slow"}, &model.TaskResult{Status: model.ResultStatusNormal})
+		close(slow)
+	}()
+	waitUntil(t, func() bool {
+		h.mutex.Lock()
+		defer h.mutex.Unlock()
+		return h.histories["slow"] != nil
+	})
+
+	// 巡检项 slow 的结果历史保存阻塞时，其他巡检项仍然可以记录结果
+	fast := make(chan struct{})
+	go func() {
+		h.Annotate("task", model.TaskItem{Type: "fake", HistoryKey: "fast"}, &model.TaskResult{Status: model.ResultStatusNormal})
+		close(fast)
+	}()
+	select {
+	case <-fast:
+	case <-time.After(time.Second):
+		t.Fatal("Annotate blocked by another item's save")
+	}
+
+	close(store.release)
+	<-slow
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if store.saved["slow"] != 1 || store.saved["fast"] != 1 {
+		t.Errorf("saved = %v, want one entry for each item", store.saved)
+	}
+}
+
+func float64Ptr(v float64) *float64 { return &v }
+
+// waitUntil 等待 cond 成立，超时则测试失败
+func waitUntil(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met in time")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"time"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+	candyAgent "github.mokaz111.com/candy-agent/hertz_gen/candyAgent"
+)
+
+// convertScheduleRequest 将定时巡检请求转换为内部模型
+func convertScheduleRequest(req *candyAgent.ScheduleRequest) *model.Schedule {
+	return &model.Schedule{
+		ID:              req.ScheduleId,
+		Name:            req.Name,
+		Cron:            req.Cron,
+		Items:           convertTaskItems(req.Items),
+		Timeout:         int(req.Timeout),
+		Parallelism:     int(req.Parallelism),
+		Priority:        int(req.Priority),
+		Disabled:        req.Disabled,
+		MissedRunPolicy: model.MissedRunPolicy(req.MissedRunPolicy),
+		OverlapPolicy:   model.OverlapPolicy(req.OverlapPolicy),
+	}
+}
+
+// convertSchedule 转换定时巡检
+func convertSchedule(schedule *model.Schedule) *candyAgent.Schedule {
+	converted := &candyAgent.Schedule{
+		Id:              schedule.ID,
+		Name:            schedule.Name,
+		Cron:            schedule.Cron,
+		Items:           convertModelTaskItems(schedule.Items),
+		Timeout:         int32(schedule.Timeout),
+		Parallelism:     int32(schedule.Parallelism),
+		Priority:        int32(schedule.Priority),
+		Disabled:        schedule.Disabled,
+		MissedRunPolicy: string(schedule.MissedRunPolicy),
+		OverlapPolicy:   string(schedule.OverlapPolicy),
+		LastTaskId:      schedule.LastTaskID,
+		CreatedAt:       schedule.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:       schedule.UpdatedAt.Format(time.RFC3339),
+	}
+	if !schedule.LastRunTime.IsZero() {
+		converted.LastRunTime = schedule.LastRunTime.Format(time.RFC3339)
+	}
+	if next := GetScheduler().NextRunTime(schedule.ID); !next.IsZero() {
+		converted.NextRunTime = next.Format(time.RFC3339)
+	}
+	return converted
+}
+
+// convertModelTaskItems 将内部任务项转换为接口格式
+func convertModelTaskItems(items []model.TaskItem) []*candyAgent.TaskItem {
+	converted := make([]*candyAgent.TaskItem, 0, len(items))
+	for _, item := range items {
+		convertedItem := &candyAgent.TaskItem{
+			Id:             int64(item.ID),
+			Name:           item.Name,
+			Type:           item.Type,
+			Params:         make(map[string]string, len(item.Params)),
+			TimeoutSeconds: int32(item.TimeoutSeconds),
+			Retries:        int32(item.Retries),
+			RetryBackoff:   int32(item.RetryBackoff),
+			When:           item.When,
+			Weight:         item.Weight,
+			HistoryKey:     item.HistoryKey,
+		}
+		for k, v := range item.Params {
+			if s, ok := v.(string); ok {
+				convertedItem.Params[k] = s
+			}
+		}
+		for _, dep := range item.DependsOn {
+			convertedItem.DependsOn = append(convertedItem.DependsOn, int64(dep))
+		}
+		for _, condition := range item.RetryOn {
+			convertedItem.RetryOn = append(convertedItem.RetryOn, string(condition))
+		}
+		converted = append(converted, convertedItem)
+	}
+	return converted
+}
@@ -0,0 +1,368 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/robfig/cron/v3"
+	"github.mokaz111.com/candy-agent/biz/model"
+	"github.mokaz111.com/candy-agent/conf"
+)
+
+// Scheduler 定时巡检调度器，按 cron 表达式向任务管理器提交任务，结果通过任务回调上报
+type Scheduler struct {
+	cron      *cron.Cron
+	store     model.ScheduleStore
+	schedules map[string]*model.Schedule
+	entries   map[string]cron.EntryID // 定时巡检ID -> cron 任务ID
+	mutex     sync.Mutex
+}
+
+var (
+	schedulerInstance *Scheduler
+	schedulerOnce     sync.Once
+)
+
+// GetScheduler 获取定时巡检调度器单例
+func GetScheduler() *Scheduler {
+	schedulerOnce.Do(func() {
+		schedulerInstance = &Scheduler{
+			cron:      cron.New(),
+			store:     newScheduleStore(),
+			schedules: make(map[string]*model.Schedule),
+			entries:   make(map[string]cron.EntryID),
+		}
+	})
+	return schedulerInstance
+}
+
+// newScheduleStore 根据配置创建定时巡检存储，创建失败时退化为内存存储
+func newScheduleStore() model.ScheduleStore {
+	cfg := conf.GetConf().Scheduler
+	return newStore(storeConfig{name: "定时巡检", kind: cfg.Store, dir: cfg.StoreDir, defaultDir: "data/schedules"},
+		func(schedule *model.Schedule) string { return schedule.ID })
+}
+
+// Start 加载已保存的定时巡检并启动调度，按错过执行策略补执行 Agent 停止期间错过的巡检
+func (s *Scheduler) Start() {
+	schedules, err := s.store.LoadAll()
+	if err != nil {
+		hlog.Errorf("加载定时巡检失败: %v", err)
+	}
+
+	now := time.Now()
+	s.mutex.Lock()
+	var missed []string
+	for _, schedule := range schedules {
+		if err := s.register(schedule); err != nil {
+			hlog.Errorf("注册定时巡检 %s 失败: %v", schedule.ID, err)
+			continue
+		}
+		if !schedule.Disabled && schedule.MissedRunPolicy == model.MissedRunOnce && s.missedRun(schedule, now) {
+			missed = append(missed, schedule.ID)
+		}
+	}
+	s.mutex.Unlock()
+
+	s.cron.Start()
+	hlog.Infof("定时巡检调度器已启动，已加载 %d 个定时巡检", len(schedules))
+
+	for _, scheduleID := range missed {
+		hlog.Infof("定时巡检 %s 在 Agent 停止期间错过执行，立即补执行", scheduleID)
+		go s.run(scheduleID)
+	}
+}
+
+// Stop 停止调度，不再提交新的任务
+func (s *Scheduler) Stop() {
+	<-s.cron.Stop().Done()
+}
+
+// missedRun 判断定时巡检在上次执行后是否有错过的执行时间
+func (s *Scheduler) missedRun(schedule *model.Schedule, now time.Time) bool {
+	spec, err := cron.ParseStandard(schedule.Cron)
+	if err != nil {
+		return false
+	}
+	since := schedule.LastRunTime
+	if since.IsZero() {
+		since = schedule.CreatedAt
+	}
+	return spec.Next(since).Before(now)
+}
+
+// Create 创建定时巡检，未指定ID时自动生成
+func (s *Scheduler) Create(schedule *model.Schedule) (*model.Schedule, error) {
+	if schedule.ID == "" {
+		schedule.ID = newScheduleID()
+	}
+	if err := validateSchedule(schedule); err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, exists := s.schedules[schedule.ID]; exists {
+		return nil, fmt.Errorf("定时巡检已存在: %s", schedule.ID)
+	}
+
+	now := time.Now()
+	schedule.CreatedAt = now
+	schedule.UpdatedAt = now
+	schedule.LastRunTime = time.Time{}
+	schedule.LastTaskID = ""
+	if err := s.register(schedule); err != nil {
+		return nil, err
+	}
+	s.persist(schedule)
+
+	hlog.Infof("已创建定时巡检: %s (%s)", schedule.ID, schedule.Cron)
+	snapshot := *schedule
+	return &snapshot, nil
+}
+
+// Update 更新定时巡检的配置，保留执行记录
+func (s *Scheduler) Update(schedule *model.Schedule) (*model.Schedule, error) {
+	if err := validateSchedule(schedule); err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	existing, exists := s.schedules[schedule.ID]
+	if !exists {
+		return nil, fmt.Errorf("定时巡检不存在: %s", schedule.ID)
+	}
+
+	schedule.CreatedAt = existing.CreatedAt
+	schedule.UpdatedAt = time.Now()
+	schedule.LastRunTime = existing.LastRunTime
+	schedule.LastTaskID = existing.LastTaskID
+
+	s.unregister(schedule.ID)
+	if err := s.register(schedule); err != nil {
+		// 新配置注册失败时恢复旧配置
+		s.register(existing)
+		return nil, err
+	}
+	s.persist(schedule)
+
+	hlog.Infof("已更新定时巡检: %s (%s)", schedule.ID, schedule.Cron)
+	snapshot := *schedule
+	return &snapshot, nil
+}
+
+// Delete 删除定时巡检，已提交的任务不受影响
+func (s *Scheduler) Delete(scheduleID string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, exists := s.schedules[scheduleID]; !exists {
+		return fmt.Errorf("定时巡检不存在: %s", scheduleID)
+	}
+
+	s.unregister(scheduleID)
+	if err := s.store.Delete(scheduleID); err != nil {
+		hlog.Errorf("删除定时巡检 %s 失败: %v", scheduleID, err)
+	}
+
+	hlog.Infof("已删除定时巡检: %s", scheduleID)
+	return nil
+}
+
+// Get 获取定时巡检副本
+func (s *Scheduler) Get(scheduleID string) (*model.Schedule, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	schedule, exists := s.schedules[scheduleID]
+	if !exists {
+		return nil, fmt.Errorf("定时巡检不存在: %s", scheduleID)
+	}
+	snapshot := *schedule
+	return &snapshot, nil
+}
+
+// List 获取所有定时巡检副本，按创建时间排序
+func (s *Scheduler) List() []model.Schedule {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	schedules := make([]model.Schedule, 0, len(s.schedules))
+	for _, schedule := range s.schedules {
+		schedules = append(schedules, *schedule)
+	}
+	sort.Slice(schedules, func(i, j int) bool {
+		return schedules[i].CreatedAt.Before(schedules[j].CreatedAt)
+	})
+	return schedules
+}
+
+// NextRunTime 获取定时巡检的下次执行时间，暂停或未调度时返回零值
+func (s *Scheduler) NextRunTime(scheduleID string) time.Time {
+	s.mutex.Lock()
+	entryID, exists := s.entries[scheduleID]
+	s.mutex.Unlock()
+	if !exists {
+		return time.Time{}
+	}
+	return s.cron.Entry(entryID).Next
+}
+
+// register 保存定时巡检并添加到 cron，暂停的定时巡检不调度，调用方需持有锁
+func (s *Scheduler) register(schedule *model.Schedule) error {
+	if !schedule.Disabled {
+		scheduleID := schedule.ID
+		entryID, err := s.cron.AddFunc(schedule.Cron, func() { s.run(scheduleID) })
+		if err != nil {
+			return fmt.Errorf("cron 表达式无效: %v", err)
+		}
+		s.entries[schedule.ID] = entryID
+	}
+	s.schedules[schedule.ID] = schedule
+	return nil
+}
+
+// unregister 从 cron 和内存中移除定时巡检，调用方需持有锁
+func (s *Scheduler) unregister(scheduleID string) {
+	if entryID, exists := s.entries[scheduleID]; exists {
+		s.cron.Remove(entryID)
+		delete(s.entries, scheduleID)
+	}
+	delete(s.schedules, scheduleID)
+}
+
+// persist 保存定时巡检，调用方需持有锁
+func (s *Scheduler) persist(schedule *model.Schedule) {
+	if err := s.store.Save(schedule); err != nil {
+		hlog.Errorf("保存定时巡检 %s 失败: %v", schedule.ID, err)
+	}
+}
+
+// run 执行一次定时巡检，按重叠策略处理上一次未结束的任务
+func (s *Scheduler) run(scheduleID string) {
+	// 重叠检查与执行记录在同一临界区内完成，避免并发触发时都看到旧的上一次任务
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	schedule, exists := s.schedules[scheduleID]
+	if !exists {
+		return
+	}
+
+	taskManager := GetTaskManager()
+
+	// 上一次任务仍在执行时按重叠策略处理，任务已过期清理时视为已结束
+	if schedule.LastTaskID != "" {
+		if lastTask, err := taskManager.GetTaskSnapshot(schedule.LastTaskID); err == nil && !lastTask.Status.IsFinished() {
+			switch schedule.OverlapPolicy {
+			case model.OverlapAllow:
+			case model.OverlapReplace:
+				hlog.Warnf("定时巡检 %s 的上一次任务 %s 未结束，取消后重新执行", scheduleID, lastTask.ID)
+				if err := taskManager.CancelTask(lastTask.ID); err != nil {
+					hlog.Warnf("取消定时巡检 %s 的上一次任务失败: %v", scheduleID, err)
+				}
+			default:
+				hlog.Warnf("定时巡检 %s 的上一次任务 %s 未结束，跳过本次执行", scheduleID, lastTask.ID)
+				return
+			}
+		}
+	}
+
+	now := time.Now()
+	task, err := taskManager.CreateTask(&model.Task{
+		ID:          fmt.Sprintf("%s-%d", scheduleID, now.UnixMilli()),
+		Items:       copyTaskItems(schedule.Items),
+		Timeout:     schedule.Timeout,
+		Parallelism: schedule.Parallelism,
+		Priority:    schedule.Priority,
+		ScheduleID:  scheduleID,
+	})
+	if err != nil {
+		hlog.Errorf("定时巡检 %s 提交任务失败: %v", scheduleID, err)
+		return
+	}
+	hlog.Infof("定时巡检 %s 已提交任务 %s", scheduleID, task.ID)
+
+	schedule.LastRunTime = now
+	schedule.LastTaskID = task.ID
+	s.persist(schedule)
+}
+
+// validateSchedule 校验定时巡检配置并填充默认策略
+func validateSchedule(schedule *model.Schedule) error {
+	if schedule.Cron == "" {
+		return fmt.Errorf("cron 表达式不能为空")
+	}
+	if _, err := cron.ParseStandard(schedule.Cron); err != nil {
+		return fmt.Errorf("cron 表达式无效: %v", err)
+	}
+	if len(schedule.Items) == 0 {
+		return fmt.Errorf("定时巡检至少需要一个任务项")
+	}
+	if _, err := buildTaskGraph(schedule.Items); err != nil {
+		return err
+	}
+	for _, item := range schedule.Items {
+		if err := validateRetryPolicy(item); err != nil {
+			return err
+		}
+		if err := validateItemWeight(item); err != nil {
+			return err
+		}
+	}
+	// 与提交任务时相同的参数校验，默认值在每次执行时补齐，保存的配置保持用户输入
+	if err := GetTaskManager().validateTaskItemParams(copyTaskItems(schedule.Items)); err != nil {
+		return err
+	}
+
+	switch schedule.MissedRunPolicy {
+	case "":
+		schedule.MissedRunPolicy = model.MissedRunSkip
+	case model.MissedRunSkip, model.MissedRunOnce:
+	default:
+		return fmt.Errorf("不支持的错过执行策略: %s", schedule.MissedRunPolicy)
+	}
+
+	switch schedule.OverlapPolicy {
+	case "":
+		schedule.OverlapPolicy = model.OverlapSkip
+	case model.OverlapSkip, model.OverlapAllow, model.OverlapReplace:
+	default:
+		return fmt.Errorf("不支持的重叠执行策略: %s", schedule.OverlapPolicy)
+	}
+
+	if schedule.Timeout <= 0 {
+		schedule.Timeout = 30 // 默认30秒
+	}
+	return nil
+}
+
+// copyTaskItems 复制任务项，避免多次执行共享参数
+func copyTaskItems(items []model.TaskItem) []model.TaskItem {
+	copied := make([]model.TaskItem, 0, len(items))
+	for _, item := range items {
+		params := make(map[string]interface{}, len(item.Params))
+		for k, v := range item.Params {
+			params[k] = v
+		}
+		item.Params = params
+		copied = append(copied, item)
+	}
+	return copied
+}
+
+// newScheduleID 生成定时巡检ID
+func newScheduleID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("schedule-%d", time.Now().UnixNano())
+	}
+	return "schedule-" + hex.EncodeToString(b)
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.mokaz111.com/candy-agent/biz/model"
+)
+
+// staticScheduleStore 返回固定定时巡检的存储
+type staticScheduleStore struct {
+	schedules []*model.Schedule
+}
+
+func (s staticScheduleStore) Save(schedule *model.Schedule) error { return nil }
+func (s staticScheduleStore) Delete(scheduleID string) error      { return nil }
+func (s staticScheduleStore) LoadAll() ([]*model.Schedule, error) { return s.schedules, nil }
+
+func newTestScheduler(schedules ...*model.Schedule) *Scheduler {
+	return &Scheduler{
+		cron:      cron.New(),
+		store:     staticScheduleStore{schedules: schedules},
+		schedules: make(map[string]*model.Schedule),
+		entries:   make(map[string]cron.EntryID),
+	}
+}
+
+func TestSchedulerMissedRun(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 30, 0, 0, time.Local)
+	tests := []struct {
+		name     string
+		schedule model.Schedule
+		want     bool
+	}{
+		{name: "ran this hour", schedule: model.Schedule{Cron: "0 * * * *", LastRunTime: now.Add(-30 * time.Minute)}, want: false},
+		{name: "missed one hour", schedule: model.Schedule{Cron: "0 * * * *", LastRunTime: now.Add(-90 * time.Minute)}, want: true},
+		{name: "never ran, created after last slot", schedule: model.Schedule{Cron: "0 * * * *", CreatedAt: now.Add(-20 * time.Minute)}, want: false},
+		{name: "never ran, created before last slot", schedule: model.Schedule{Cron: "0 * * * *", CreatedAt: now.Add(-40 * time.Minute)}, want: true},
+		{name: "descriptor", schedule: model.Schedule{Cron: "@every 1h", LastRunTime: now.Add(-2 * time.Hour)}, want: true},
+		{name: "invalid cron", schedule: model.Schedule{Cron: "bad", LastRunTime: now.Add(-24 * time.Hour)}, want: false},
+	}
+	s := newTestScheduler()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.missedRun(&tt.schedule, now); got != tt.want {
+				t.Fatalf("missedRun = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSchedulerStartRunsMissedOnce(t *testing.T) {
+	id := testTaskID(t)
+	lastRun := time.Now().Add(-3 * time.Hour)
+	newSchedule := func(suffix string, policy model.MissedRunPolicy) *model.Schedule {
+		return &model.Schedule{
+			ID:              id + "-" + suffix,
+			Cron:            "0 * * * *",
+			Items:           []model.TaskItem{fakeItem(id, 1, suffix, nil)},
+			Timeout:         5,
+			MissedRunPolicy: policy,
+			OverlapPolicy:   model.OverlapSkip,
+			LastRunTime:     lastRun,
+		}
+	}
+	s := newTestScheduler(newSchedule("once", model.MissedRunOnce), newSchedule("skip", model.MissedRunSkip))
+	s.Start()
+	defer s.Stop()
+
+	waitForCalls(t, id, "once", 1)
+	if got := fakeCalls.count(id, "skip"); got != 0 {
+		t.Fatalf("skip schedule ran %d times after start", got)
+	}
+	once, _ := s.Get(id + "-once")
+	if once.LastTaskID == "" || !once.LastRunTime.After(lastRun) {
+		t.Fatalf("run_once schedule not recorded: %+v", once)
+	}
+}
+
+func TestSchedulerOverlapPolicy(t *testing.T) {
+	tm := GetTaskManager()
+	tests := []struct {
+		policy       model.OverlapPolicy
+		wantNewTask  bool
+		wantPrevious model.TaskStatus
+	}{
+		{policy: model.OverlapSkip, wantNewTask: false, wantPrevious: model.TaskStatusRunning},
+		{policy: model.OverlapAllow, wantNewTask: true, wantPrevious: model.TaskStatusRunning},
+		{policy: model.OverlapReplace, wantNewTask: true, wantPrevious: model.TaskStatusCanceled},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.policy), func(t *testing.T) {
+			id := testTaskID(t)
+
+			// 上一次任务仍在执行
+			previous := &model.Task{ID: id + "-previous", Timeout: 10,
+				Items: []model.TaskItem{fakeItem(id, 1, "previous", map[string]interface{}{"block": true})}}
+			if _, err := tm.CreateTask(previous); err != nil {
+				t.Fatalf("CreateTask: %v", err)
+			}
+			defer tm.CancelTask(previous.ID)
+			waitForCalls(t, id, "previous", 1)
+
+			s := newTestScheduler()
+			schedule := &model.Schedule{
+				ID:            id,
+				Cron:          "0 0 1 1 *",
+				Items:         []model.TaskItem{fakeItem(id, 1, "next", nil)},
+				Timeout:       5,
+				OverlapPolicy: tt.policy,
+			}
+			if _, err := s.Create(schedule); err != nil {
+				t.Fatalf("Create: %v", err)
+			}
+			s.mutex.Lock()
+			s.schedules[id].LastTaskID = previous.ID
+			s.mutex.Unlock()
+
+			s.run(id)
+
+			current, _ := s.Get(id)
+			if gotNew := current.LastTaskID != previous.ID; gotNew != tt.wantNewTask {
+				t.Fatalf("new task submitted = %v, want %v (last task %s)", gotNew, tt.wantNewTask, current.LastTaskID)
+			}
+			if tt.wantNewTask {
+				if _, err := tm.WaitTask(context.Background(), current.LastTaskID); err != nil {
+					t.Fatalf("WaitTask: %v", err)
+				}
+			}
+			if got, _ := tm.GetTaskSnapshot(previous.ID); got.Status != tt.wantPrevious {
+				t.Fatalf("previous task status = %s, want %s", got.Status, tt.wantPrevious)
+			}
+		})
+	}
+}
+
+func TestSchedulerCreateValidatesParams(t *testing.T) {
+	id := testTaskID(t)
+	tests := []struct {
+		name    string
+		item    model.TaskItem
+		wantErr string
+	}{
+		{name: "unknown executor", item: model.TaskItem{ID: 1, Name: "x", Type: "unknown"}, wantErr: "不支持的执行器类型: unknown"},
+		{name: "wrong param type", item: fakeItem(id, 1, "bad", map[string]interface{}{"status": 1}), wantErr: "参数 status 应为字符串"},
+	}
+	s := newTestScheduler()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule := &model.Schedule{ID: id + "-" + tt.name, Cron: "0 * * * *", Items: []model.TaskItem{tt.item}}
+			_, err := s.Create(schedule)
+			var paramErr *TaskParamError
+			if !errors.As(err, &paramErr) || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Create() error = %v, want param error %q", err, tt.wantErr)
+			}
+			if _, err := s.Get(schedule.ID); err == nil {
+				t.Errorf("schedule %s was created", schedule.ID)
+			}
+		})
+	}
+}
+
+func TestSchedulerConcurrentRunsSkipOverlap(t *testing.T) {
+	id := testTaskID(t)
+	s := newTestScheduler()
+	schedule := &model.Schedule{
+		ID:            id,
+		Cron:          "0 0 1 1 *",
+		Items:         []model.TaskItem{fakeItem(id, 1, "blocked", map[string]interface{}{"block": true})},
+		Timeout:       10,
+		OverlapPolicy: model.OverlapSkip,
+	}
+	if _, err := s.Create(schedule); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.run(id)
+		}()
+	}
+	wg.Wait()
+
+	current, _ := s.Get(id)
+	defer GetTaskManager().CancelTask(current.LastTaskID)
+	waitForCalls(t, id, "blocked", 1)
+	time.Sleep(50 * time.Millisecond)
+	if got := fakeCalls.count(id, "blocked"); got != 1 {
+		t.Fatalf("blocked item executed %d times, want 1", got)
+	}
+}
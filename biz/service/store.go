@@ -0,0 +1,38 @@
+package service
+
+import (
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.mokaz111.com/candy-agent/biz/dal/filestore"
+	"github.mokaz111.com/candy-agent/biz/model"
+)
+
+// storeConfig 存储配置
+type storeConfig struct {
+	name       string // 存储名称，用于日志
+	kind       string // 存储类型: memory(默认), file
+	dir        string // 文件存储目录
+	defaultDir string // 未配置目录时使用的文件存储目录
+}
+
+// newStore 根据配置创建存储，id 返回对象在文件存储中的ID，创建失败时退化为内存存储
+func newStore[T any](cfg storeConfig, id func(v *T) string) model.Store[T] {
+	switch cfg.kind {
+	case "", "memory":
+		return model.NewMemoryStore[T]()
+	case "file":
+		dir := cfg.dir
+		if dir == "" {
+			dir = cfg.defaultDir
+		}
+		store, err := filestore.New(dir, id)
+		if err != nil {
+			hlog.Errorf("创建文件%s存储失败，使用内存存储: %v", cfg.name, err)
+			return model.NewMemoryStore[T]()
+		}
+		hlog.Infof("使用文件%s存储: %s", cfg.name, dir)
+		return store
+	default:
+		hlog.Errorf("未知的%s存储类型: %s，使用内存存储", cfg.name, cfg.kind)
+		return model.NewMemoryStore[T]()
+	}
+}
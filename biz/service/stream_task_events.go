@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.mokaz111.com/candy-agent/biz/model"
+	"github.mokaz111.com/candy-agent/biz/utils"
+	candyAgent "github.mokaz111.com/candy-agent/hertz_gen/candyAgent"
+)
+
+// taskEventHeartbeatInterval 事件流心跳间隔，避免代理因空闲断开连接
+const taskEventHeartbeatInterval = 15 * time.Second
+
+type StreamTaskEventsService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewStreamTaskEventsService(Context context.Context, RequestContext *app.RequestContext) *StreamTaskEventsService {
+	return &StreamTaskEventsService{RequestContext: RequestContext, Context: Context}
+}
+
+// Run 以 Server-Sent Events 推送任务状态变化和任务项结果，任务结束时发送 done 事件后关闭连接
+// 只有开始推送前的错误会返回，推送过程中连接断开直接结束
+func (h *StreamTaskEventsService) Run(req *candyAgent.TaskStatusRequest) (resp *candyAgent.Empty, err error) {
+	if req.TaskId == "" {
+		return nil, fmt.Errorf("任务ID不能为空")
+	}
+
+	taskManager := GetTaskManager()
+	task, err := taskManager.GetTask(req.TaskId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 先订阅再读取当前状态，避免遗漏两者之间产生的事件
+	events, unsubscribe := taskManager.SubscribeTaskEvents(task.ID)
+	defer unsubscribe()
+
+	snapshot, err := taskManager.GetTaskSnapshot(task.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	hlog.CtxInfof(h.Context, "Streaming events of task %s", task.ID)
+	stream := &taskEventStream{
+		writer: utils.NewSSEWriter(h.RequestContext),
+		sent:   make(map[uint]bool),
+	}
+	stream.sendStatus(snapshot)
+	stream.sendResults(snapshot.Results)
+
+	heartbeat := time.NewTicker(taskEventHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for stream.err == nil {
+		select {
+		case event := <-events:
+			switch event.Type {
+			case TaskEventStatus:
+				if snapshot, err := taskManager.GetTaskSnapshot(task.ID); err == nil {
+					snapshot.Status = event.Status
+					stream.sendStatus(snapshot)
+				}
+			case TaskEventResult:
+				stream.sendResults([]model.TaskResult{*event.Result})
+			}
+		case <-task.Done:
+			// 任务结束后以最终状态补发遗漏的结果，再发送结束事件
+			if snapshot, err := taskManager.GetTaskSnapshot(task.ID); err == nil {
+				stream.sendResults(snapshot.Results)
+				stream.sendStatus(snapshot)
+				stream.send(string(TaskEventDone), buildTaskResponse(snapshot))
+			}
+			return nil, nil
+		case <-heartbeat.C:
+			stream.err = stream.writer.Comment("ping")
+		}
+	}
+
+	hlog.CtxInfof(h.Context, "Event stream of task %s closed: %v", task.ID, stream.err)
+	return nil, nil
+}
+
+// taskEventStream 单个连接的任务事件流，记录已发送的任务项避免重复推送
+type taskEventStream struct {
+	writer *utils.SSEWriter
+	sent   map[uint]bool
+	err    error
+}
+
+// send 发送事件，连接出错后不再发送
+func (s *taskEventStream) send(event string, data interface{}) {
+	if s.err != nil {
+		return
+	}
+	s.err = s.writer.Send(event, data)
+}
+
+// sendStatus 发送任务状态和进度
+func (s *taskEventStream) sendStatus(task *model.Task) {
+	s.send(string(TaskEventStatus), buildTaskStatusResponse(task))
+}
+
+// sendResults 发送尚未推送过的任务项结果
+func (s *taskEventStream) sendResults(results []model.TaskResult) {
+	for _, result := range results {
+		if s.sent[result.ItemID] {
+			continue
+		}
+		s.sent[result.ItemID] = true
+		s.send(string(TaskEventResult), convertTaskResults([]model.TaskResult{result})[0])
+	}
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/app/server"
+	"github.mokaz111.com/candy-agent/biz/model"
+	candyAgent "github.mokaz111.com/candy-agent/hertz_gen/candyAgent"
+)
+
+// sseEvent 事件流中的一个事件
+type sseEvent struct {
+	name string
+	data string
+}
+
+// eventClient 读取事件流的客户端，不复用连接，测试结束时服务可以立即关闭
+var eventClient = &http.Client{Timeout: 5 * time.Second, Transport: &http.Transport{DisableKeepAlives: true}}
+
+// startEventServer 启动只提供任务事件流的 Hertz 服务，返回服务地址
+func startEventServer(t *testing.T) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+
+	h := server.New(server.WithHostPorts(addr), server.WithExitWaitTime(0))
+	h.GET("/api/v1/tasks/:task_id/events", func(ctx context.Context, c *app.RequestContext) {
+		if _, err := NewStreamTaskEventsService(ctx, c).Run(&candyAgent.TaskStatusRequest{TaskId: c.Param("task_id")}); err != nil {
+			c.String(http.StatusNotFound, err.Error())
+		}
+	})
+	go h.Run()
+	t.Cleanup(func() { h.Shutdown(context.Background()) })
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		conn, err := net.Dial("tcp", addr)
+		if err == nil {
+			conn.Close()
+			return "http://" + addr
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("event server not ready: %v", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// readEvents 读取事件流直到连接关闭
+func readEvents(t *testing.T, body *bufio.Scanner) []sseEvent {
+	t.Helper()
+	var events []sseEvent
+	var current sseEvent
+	for body.Scan() {
+		line := body.Text()
+		switch {
+		case strings.HasPrefix(line, "event: "):
+			current.name = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			current.data = strings.TrimPrefix(line, "data: ")
+		case line == "" && current.name != "":
+			events = append(events, current)
+			current = sseEvent{}
+		}
+	}
+	return events
+}
+
+func TestStreamTaskEventsEndsWithDone(t *testing.T) {
+	base := startEventServer(t)
+	tm := GetTaskManager()
+	id := testTaskID(t)
+	task := &model.Task{
+		ID:      id,
+		Timeout: 10,
+		Items: []model.TaskItem{
+			fakeItem(id, 1, "quick", map[string]interface{}{"status": "warning"}),
+			fakeItem(id, 2, "block", map[string]interface{}{"block": true}),
+		},
+	}
+	if _, err := tm.CreateTask(task); err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	waitForCalls(t, id, "block", 1)
+
+	resp, err := eventClient.Get(base + "/api/v1/tasks/" + id + "/events")
+	if err != nil {
+		t.Fatalf("GET events: %v", err)
+	}
+	defer resp.Body.Close()
+	if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, "text/event-stream") {
+		t.Fatalf("Content-Type = %q", ct)
+	}
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		tm.CancelTask(id)
+	}()
+	events := readEvents(t, bufio.NewScanner(resp.Body))
+	if len(events) == 0 {
+		t.Fatal("no events received")
+	}
+
+	results := make(map[int64]int)
+	for _, event := range events[:len(events)-1] {
+		switch event.name {
+		case string(TaskEventResult):
+			var result candyAgent.TaskResult
+			if err := json.Unmarshal([]byte(event.data), &result); err != nil {
+				t.Fatalf("result event %q: %v", event.data, err)
+			}
+			results[result.ItemId]++
+		case string(TaskEventDone):
+			t.Fatal("done event before the end of the stream")
+		}
+	}
+	if results[1] != 1 {
+		t.Fatalf("result events per item = %v, want item 1 exactly once", results)
+	}
+
+	last := events[len(events)-1]
+	if last.name != string(TaskEventDone) {
+		t.Fatalf("last event = %s, want %s", last.name, TaskEventDone)
+	}
+	var done candyAgent.TaskResponse
+	if err := json.Unmarshal([]byte(last.data), &done); err != nil {
+		t.Fatalf("done event %q: %v", last.data, err)
+	}
+	if done.TaskId != id || done.Status != candyAgent.TaskStatus_TASK_STATUS_CANCELED {
+		t.Fatalf("done event = %s %s, want %s canceled", done.TaskId, done.Status, id)
+	}
+}
+
+func TestStreamTaskEventsUnknownTask(t *testing.T) {
+	base := startEventServer(t)
+	resp, err := eventClient.Get(base + "/api/v1/tasks/missing/events")
+	if err != nil {
+		t.Fatalf("GET events: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("status = %d, want 404 before the stream starts", resp.StatusCode)
+	}
+}
@@ -0,0 +1,528 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+)
+
+// taskCondition 任务项执行条件，根据同一任务中之前任务项的结果决定是否执行。
+//
+// 表达式由比较组成，可以使用 &&、||、! 和括号组合：
+//
+//	items.1.status == "warning" && items.2.value > 80
+//	items.3.labels.instance =~ "^node-" || !(items.3.message == "")
+//
+// 比较的一侧可以引用任务项结果 items.<ID>.status/value/message/labels.<标签名>/json.<路径>，
+// 另一侧为字符串或数字，标签名和路径中可以包含 / 和 -。比较符为 ==、!=、>、>=、<、<=、=~(正则匹配)、!~(正则不匹配)，
+// 两侧都是数字时按数值比较，否则按字符串比较，大小比较要求两侧都是数字
+type taskCondition struct {
+	expr conditionExpr
+	refs []uint // 引用的任务项ID
+}
+
+// conditionExpr 条件表达式节点
+type conditionExpr interface {
+	eval(results map[uint]model.TaskResult) (bool, error)
+}
+
+// parseTaskCondition 解析任务项执行条件
+func parseTaskCondition(s string) (*taskCondition, error) {
+	tokens, err := tokenizeCondition(s)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := &conditionParser{tokens: tokens, refs: make(map[uint]bool)}
+	expr, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !parser.done() {
+		return nil, fmt.Errorf("无法解析 %q", parser.peek().text)
+	}
+
+	condition := &taskCondition{expr: expr}
+	for id := range parser.refs {
+		condition.refs = append(condition.refs, id)
+	}
+	sort.Slice(condition.refs, func(i, j int) bool { return condition.refs[i] < condition.refs[j] })
+	return condition, nil
+}
+
+// checkItemCondition 使用依赖任务项的结果计算执行条件，条件不满足时返回跳过结果，
+// 计算出错时返回失败结果，需要执行任务项时 skip 为 false
+func checkItemCondition(item model.TaskItem, condition *taskCondition, graph *taskGraph, itemResults []*model.TaskResult) (result model.TaskResult, skip bool) {
+	results := make(map[uint]model.TaskResult, len(condition.refs))
+	for _, refID := range condition.refs {
+		if ref := itemResults[graph.index[refID]]; ref != nil {
+			results[refID] = *ref
+		}
+	}
+
+	matched, err := condition.Eval(results)
+	switch {
+	case err != nil:
+		return model.TaskResult{
+			ItemID:  item.ID,
+			Status:  model.ResultStatusFailed,
+			Message: fmt.Sprintf("执行条件计算失败: %v", err),
+			Details: fmt.Sprintf("When: %s", item.When),
+		}, true
+	case !matched:
+		return model.TaskResult{
+			ItemID:  item.ID,
+			Status:  model.ResultStatusSkipped,
+			Message: "执行条件不满足，跳过执行",
+			Details: fmt.Sprintf("When: %s", item.When),
+		}, true
+	default:
+		return model.TaskResult{}, false
+	}
+}
+
+// Eval 使用任务项结果计算条件
+func (c *taskCondition) Eval(results map[uint]model.TaskResult) (bool, error) {
+	return c.expr.eval(results)
+}
+
+// conditionTokenKind 条件表达式词法单元类型
+type conditionTokenKind int
+
+const (
+	tokenRef conditionTokenKind = iota
+	tokenString
+	tokenNumber
+	tokenOperator
+)
+
+// conditionToken 条件表达式词法单元
+type conditionToken struct {
+	kind conditionTokenKind
+	text string
+}
+
+// conditionOperators 支持的运算符，长的在前
+var conditionOperators = []string{"&&", "||", "==", "!=", ">=", "<=", "=~", "!~", ">", "<", "!", "(", ")"}
+
+// tokenizeCondition 将条件表达式拆分为词法单元
+func tokenizeCondition(s string) ([]conditionToken, error) {
+	var tokens []conditionToken
+	for i := 0; i < len(s); {
+		c := rune(s[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '"' || c == '\'':
+			text, next, err := readConditionString(s, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, conditionToken{kind: tokenString, text: text})
+			i = next
+		case unicode.IsDigit(c) || (c == '-' && i+1 < len(s) && unicode.IsDigit(rune(s[i+1]))):
+			j := i + 1
+			for j < len(s) && (unicode.IsDigit(rune(s[j])) || s[j] == '.') {
+				j++
+			}
+			tokens = append(tokens, conditionToken{kind: tokenNumber, text: s[i:j]})
+			i = j
+		case unicode.IsLetter(c) || c == '_':
+			j := i + 1
+			for j < len(s) && isRefChar(rune(s[j])) {
+				j++
+			}
+			tokens = append(tokens, conditionToken{kind: tokenRef, text: s[i:j]})
+			i = j
+		default:
+			matched := false
+			for _, op := range conditionOperators {
+				if strings.HasPrefix(s[i:], op) {
+					tokens = append(tokens, conditionToken{kind: tokenOperator, text: op})
+					i += len(op)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("无法识别的字符 %q", s[i])
+			}
+		}
+	}
+	return tokens, nil
+}
+
+// isRefChar 是否为结果引用中的字符，标签名可以包含 / 和 -，如 app.kubernetes.io/name
+func isRefChar(c rune) bool {
+	return unicode.IsLetter(c) || unicode.IsDigit(c) || c == '_' || c == '.' || c == '/' || c == '-'
+}
+
+// readConditionString 读取引号包围的字符串，支持反斜杠转义，返回字符串内容和结束位置
+func readConditionString(s string, start int) (string, int, error) {
+	quote := s[start]
+	var b strings.Builder
+	for i := start + 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i+1 < len(s) {
+				i++
+				b.WriteByte(s[i])
+			}
+		case quote:
+			return b.String(), i + 1, nil
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return "", 0, fmt.Errorf("字符串缺少结束引号")
+}
+
+// conditionParser 条件表达式语法分析器
+type conditionParser struct {
+	tokens []conditionToken
+	pos    int
+	refs   map[uint]bool
+}
+
+func (p *conditionParser) done() bool { return p.pos >= len(p.tokens) }
+
+func (p *conditionParser) peek() conditionToken {
+	if p.done() {
+		return conditionToken{}
+	}
+	return p.tokens[p.pos]
+}
+
+// accept 下一个词法单元是指定运算符时消费它
+func (p *conditionParser) accept(op string) bool {
+	if !p.done() && p.tokens[p.pos].kind == tokenOperator && p.tokens[p.pos].text == op {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *conditionParser) parseOr() (conditionExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = logicalExpr{or: true, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *conditionParser) parseAnd() (conditionExpr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("&&") {
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = logicalExpr{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *conditionParser) parseUnary() (conditionExpr, error) {
+	if p.accept("!") {
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notExpr{expr: expr}, nil
+	}
+	if p.accept("(") {
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(")") {
+			return nil, fmt.Errorf("缺少右括号")
+		}
+		return expr, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *conditionParser) parseComparison() (conditionExpr, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	op := p.peek()
+	if op.kind != tokenOperator {
+		return nil, fmt.Errorf("缺少比较运算符")
+	}
+	switch op.text {
+	case "==", "!=", ">", ">=", "<", "<=", "=~", "!~":
+		p.pos++
+	default:
+		return nil, fmt.Errorf("缺少比较运算符，得到 %q", op.text)
+	}
+
+	right, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	expr := compareExpr{op: op.text, left: left, right: right}
+	if op.text == "=~" || op.text == "!~" {
+		if right.ref != nil {
+			return nil, fmt.Errorf("正则表达式必须是字符串")
+		}
+		expr.pattern, err = regexp.Compile(right.literal)
+		if err != nil {
+			return nil, fmt.Errorf("正则表达式无效: %v", err)
+		}
+	}
+	return expr, nil
+}
+
+func (p *conditionParser) parseOperand() (conditionOperand, error) {
+	if p.done() {
+		return conditionOperand{}, fmt.Errorf("表达式不完整")
+	}
+
+	token := p.tokens[p.pos]
+	p.pos++
+	switch token.kind {
+	case tokenString, tokenNumber:
+		return conditionOperand{literal: token.text}, nil
+	case tokenRef:
+		ref, err := parseResultRef(token.text)
+		if err != nil {
+			return conditionOperand{}, err
+		}
+		p.refs[ref.itemID] = true
+		return conditionOperand{ref: ref}, nil
+	default:
+		return conditionOperand{}, fmt.Errorf("无法解析 %q", token.text)
+	}
+}
+
+// errResultFieldMissing 引用的标签或 JSON 字段在结果中不存在
+var errResultFieldMissing = errors.New("结果中不存在该字段")
+
+// resultRef 对任务项结果字段的引用，格式为 items.<ID>.status/value/message/labels.<标签名>/json.<路径>
+type resultRef struct {
+	itemID uint
+	field  string
+	path   string // 标签名或 JSON 路径
+}
+
+// parseResultRef 解析任务项结果引用
+func parseResultRef(s string) (*resultRef, error) {
+	parts := strings.SplitN(s, ".", 4)
+	if len(parts) < 3 || parts[0] != "items" {
+		return nil, fmt.Errorf("无效的结果引用 %q，格式为 items.<ID>.<字段>", s)
+	}
+
+	id, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("无效的结果引用 %q，任务项ID必须是数字", s)
+	}
+
+	ref := &resultRef{itemID: uint(id), field: parts[2]}
+	switch ref.field {
+	case "status", "value", "message":
+		if len(parts) > 3 {
+			return nil, fmt.Errorf("无效的结果引用 %q", s)
+		}
+	case "labels", "json":
+		if len(parts) < 4 || parts[3] == "" {
+			return nil, fmt.Errorf("无效的结果引用 %q，需要指定标签名或字段路径", s)
+		}
+		ref.path = parts[3]
+	default:
+		return nil, fmt.Errorf("无效的结果引用 %q，支持的字段: status, value, message, labels.<标签名>, json.<路径>", s)
+	}
+	return ref, nil
+}
+
+// String 返回引用的文本形式
+func (r *resultRef) String() string {
+	if r.path != "" {
+		return fmt.Sprintf("items.%d.%s.%s", r.itemID, r.field, r.path)
+	}
+	return fmt.Sprintf("items.%d.%s", r.itemID, r.field)
+}
+
+// resolve 获取引用的结果字段值，标签或 JSON 字段不存在时返回 errResultFieldMissing
+func (r *resultRef) resolve(results map[uint]model.TaskResult) (string, error) {
+	result, exists := results[r.itemID]
+	if !exists {
+		return "", fmt.Errorf("任务项 %d 没有执行结果", r.itemID)
+	}
+	switch r.field {
+	case "status":
+		return string(result.Status), nil
+	case "value":
+		return result.Value, nil
+	case "message":
+		return result.Message, nil
+	case "labels":
+		value, exists := result.Labels[r.path]
+		if !exists {
+			return "", fmt.Errorf("标签 %s: %w", r.path, errResultFieldMissing)
+		}
+		return value, nil
+	default:
+		return resolveJSONPath(result.Value, r.path)
+	}
+}
+
+// resolveJSONPath 将结果值解析为 JSON 并按点分隔的路径取值，数组使用下标
+func resolveJSONPath(value, path string) (string, error) {
+	var current interface{}
+	if err := json.Unmarshal([]byte(value), &current); err != nil {
+		return "", fmt.Errorf("结果值不是有效的 JSON: %v", err)
+	}
+
+	for _, key := range strings.Split(path, ".") {
+		switch node := current.(type) {
+		case map[string]interface{}:
+			next, exists := node[key]
+			if !exists {
+				return "", fmt.Errorf("json.%s: %w", path, errResultFieldMissing)
+			}
+			current = next
+		case []interface{}:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(node) {
+				return "", fmt.Errorf("json.%s: %w", path, errResultFieldMissing)
+			}
+			current = node[i]
+		default:
+			return "", fmt.Errorf("json.%s: %w", path, errResultFieldMissing)
+		}
+	}
+
+	switch v := current.(type) {
+	case string:
+		return v, nil
+	case nil:
+		return "", nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	default:
+		data, err := json.Marshal(v)
+		return string(data), err
+	}
+}
+
+// conditionOperand 比较运算的操作数
+type conditionOperand struct {
+	ref     *resultRef
+	literal string
+}
+
+// value 获取操作数的值，引用的标签或 JSON 字段不存在时视为空字符串
+func (o conditionOperand) value(results map[uint]model.TaskResult) (string, error) {
+	if o.ref == nil {
+		return o.literal, nil
+	}
+	value, err := o.ref.resolve(results)
+	if errors.Is(err, errResultFieldMissing) {
+		return "", nil
+	}
+	return value, err
+}
+
+// logicalExpr 与/或运算
+type logicalExpr struct {
+	or          bool
+	left, right conditionExpr
+}
+
+func (e logicalExpr) eval(results map[uint]model.TaskResult) (bool, error) {
+	left, err := e.left.eval(results)
+	if err != nil {
+		return false, err
+	}
+	if left == e.or {
+		return left, nil
+	}
+	return e.right.eval(results)
+}
+
+// notExpr 非运算
+type notExpr struct {
+	expr conditionExpr
+}
+
+func (e notExpr) eval(results map[uint]model.TaskResult) (bool, error) {
+	value, err := e.expr.eval(results)
+	return !value, err
+}
+
+// compareExpr 比较运算
+type compareExpr struct {
+	op          string
+	left, right conditionOperand
+	pattern     *regexp.Regexp
+}
+
+func (e compareExpr) eval(results map[uint]model.TaskResult) (bool, error) {
+	left, err := e.left.value(results)
+	if err != nil {
+		return false, err
+	}
+	if e.pattern != nil {
+		return e.pattern.MatchString(left) == (e.op == "=~"), nil
+	}
+
+	right, err := e.right.value(results)
+	if err != nil {
+		return false, err
+	}
+
+	leftNum, leftErr := strconv.ParseFloat(strings.TrimSpace(left), 64)
+	rightNum, rightErr := strconv.ParseFloat(strings.TrimSpace(right), 64)
+	numeric := leftErr == nil && rightErr == nil
+
+	switch e.op {
+	case "==":
+		if numeric {
+			return leftNum == rightNum, nil
+		}
+		return left == right, nil
+	case "!=":
+		if numeric {
+			return leftNum != rightNum, nil
+		}
+		return left != right, nil
+	}
+
+	if !numeric {
+		return false, fmt.Errorf("%q %s %q 需要两侧都是数字", left, e.op, right)
+	}
+	switch e.op {
+	case ">":
+		return leftNum > rightNum, nil
+	case ">=":
+		return leftNum >= rightNum, nil
+	case "<":
+		return leftNum < rightNum, nil
+	default:
+		return leftNum <= rightNum, nil
+	}
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+)
+
+func TestParseTaskConditionErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr string
+	}{
+		{name: "empty", expr: "", wantErr: "表达式不完整"},
+		{name: "missing operator", expr: "items.1.status", wantErr: "缺少比较运算符"},
+		{name: "logical instead of comparison", expr: `items.1.status && items.2.status == "normal"`, wantErr: "缺少比较运算符"},
+		{name: "missing right operand", expr: "items.1.value >", wantErr: "表达式不完整"},
+		{name: "unterminated string", expr: `items.1.status == "normal`, wantErr: "缺少结束引号"},
+		{name: "unknown character", expr: "items.1.value == 1 ; rm", wantErr: "无法识别的字符"},
+		{name: "missing right paren", expr: `(items.1.status == "normal"`, wantErr: "缺少右括号"},
+		{name: "trailing token", expr: `items.1.status == "normal")`, wantErr: "无法解析"},
+		{name: "not items", expr: `tasks.1.status == "normal"`, wantErr: "无效的结果引用"},
+		{name: "non numeric id", expr: `items.a.status == "normal"`, wantErr: "任务项ID必须是数字"},
+		{name: "unknown field", expr: `items.1.output == "x"`, wantErr: "支持的字段"},
+		{name: "field with path", expr: `items.1.status.x == "x"`, wantErr: "无效的结果引用"},
+		{name: "label without name", expr: `items.1.labels == "x"`, wantErr: "需要指定标签名"},
+		{name: "regex from ref", expr: "items.1.value =~ items.2.value", wantErr: "正则表达式必须是字符串"},
+		{name: "invalid regex", expr: `items.1.value =~ "("`, wantErr: "正则表达式无效"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseTaskCondition(tt.expr)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("parseTaskCondition(%q) error = %v, want %q", tt.expr, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseTaskConditionRefs(t *testing.T) {
+	tests := []struct {
+		expr string
+		want []uint
+	}{
+		{expr: `items.3.status == "normal" || items.1.value > 2 && items.3.value < 5`, want: []uint{1, 3}},
+		{expr: `items.2.labels.app.kubernetes.io/name == "nginx"`, want: []uint{2}},
+		{expr: `items.4.json.data.ready-replicas >= 1`, want: []uint{4}},
+	}
+	for _, tt := range tests {
+		condition, err := parseTaskCondition(tt.expr)
+		if err != nil {
+			t.Fatalf("parseTaskCondition(%q) error = %v", tt.expr, err)
+		}
+		if !reflect.DeepEqual(condition.refs, tt.want) {
+			t.Errorf("parseTaskCondition(%q) refs = %v, want %v", tt.expr, condition.refs, tt.want)
+		}
+	}
+}
+
+func TestTaskConditionEval(t *testing.T) {
+	results := map[uint]model.TaskResult{
+		1: {ItemID: 1, Status: model.ResultStatusWarning, Value: "85.0", Message: "disk usage high"},
+		2: {ItemID: 2, Status: model.ResultStatusNormal, Value: "10", Labels: map[string]string{
+			"app.kubernetes.io/name": "nginx",
+			"zone":                   "cn-north-1",
+		}},
+		3: {ItemID: 3, Status: model.ResultStatusNormal, Value: `{"data":{"ready-replicas":3,"items":[{"name":"a"}],"ok":true}}`},
+		4: {ItemID: 4, Status: model.ResultStatusNormal, Value: "v1.10"},
+	}
+
+	tests := []struct {
+		name    string
+		expr    string
+		want    bool
+		wantErr string
+	}{
+		// 优先级
+		{name: "and binds tighter than or", expr: `items.1.status == "normal" && items.2.value == 1 || items.2.status == "normal"`, want: true},
+		{name: "or on the left of and", expr: `items.2.status == "normal" || items.1.status == "normal" && items.2.value == 1`, want: true},
+		{name: "parens override precedence", expr: `(items.2.status == "normal" || items.1.status == "normal") && items.2.value == 1`, want: false},
+		{name: "not applies to comparison", expr: `!items.1.status == "normal"`, want: true},
+		{name: "not before and", expr: `!items.1.status == "warning" && items.2.value == 10`, want: false},
+		{name: "double not", expr: `!!(items.2.value == 10)`, want: true},
+		{name: "or short circuits error", expr: `items.2.value == 10 || items.9.status == "normal"`, want: true},
+		{name: "and short circuits error", expr: `items.2.value == 1 && items.9.status == "normal"`, want: false},
+
+		// 数值与字符串比较
+		{name: "numeric equality", expr: "items.1.value == 85", want: true},
+		{name: "numeric string literal", expr: `items.1.value == "85"`, want: true},
+		{name: "numeric greater", expr: "items.1.value > 80", want: true},
+		{name: "numeric not lexical", expr: "items.2.value > 9", want: true},
+		{name: "negative number", expr: "items.2.value >= -1", want: true},
+		{name: "string equality", expr: `items.1.message == "disk usage high"`, want: true},
+		{name: "version compared as string", expr: `items.4.value == "v1.1"`, want: false},
+		{name: "string inequality", expr: `items.4.value != "v1.1"`, want: true},
+		{name: "ordering requires numbers", expr: `items.4.value > "v1.0"`, wantErr: "需要两侧都是数字"},
+		{name: "regex match", expr: `items.2.labels.zone =~ "^cn-"`, want: true},
+		{name: "regex not match", expr: `items.1.message !~ "usage"`, want: false},
+
+		// 引用
+		{name: "label with slash", expr: `items.2.labels.app.kubernetes.io/name == "nginx"`, want: true},
+		{name: "json path with dash", expr: "items.3.json.data.ready-replicas == 3", want: true},
+		{name: "json array index", expr: `items.3.json.data.items.0.name == "a"`, want: true},
+		{name: "json bool", expr: `items.3.json.data.ok == "true"`, want: true},
+		{name: "json on non json value", expr: `items.4.json.data == ""`, wantErr: "不是有效的 JSON"},
+
+		// 缺失的引用
+		{name: "missing label is empty", expr: `items.2.labels.missing == ""`, want: true},
+		{name: "missing json field is empty", expr: `items.3.json.data.missing == ""`, want: true},
+		{name: "missing json index is empty", expr: `items.3.json.data.items.5.name == ""`, want: true},
+		{name: "missing label ordering", expr: "items.2.labels.missing > 1", wantErr: "需要两侧都是数字"},
+		{name: "missing result", expr: `items.9.status == "normal"`, wantErr: "任务项 9 没有执行结果"},
+		{name: "missing result under not", expr: `!(items.9.status == "normal")`, wantErr: "任务项 9 没有执行结果"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			condition, err := parseTaskCondition(tt.expr)
+			if err != nil {
+				t.Fatalf("parseTaskCondition(%q) error = %v", tt.expr, err)
+			}
+			got, err := condition.Eval(results)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Eval(%q) error = %v, want %q", tt.expr, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Eval(%q) error = %v", tt.expr, err)
+			}
+			if got != tt.want {
+				t.Errorf("Eval(%q) = %v, want %v", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
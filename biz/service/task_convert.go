@@ -0,0 +1,242 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+	candyAgent "github.mokaz111.com/candy-agent/hertz_gen/candyAgent"
+)
+
+// convertTaskItems 将请求中的任务项转换为内部模型
+func convertTaskItems(items []*candyAgent.TaskItem) []model.TaskItem {
+	modelItems := make([]model.TaskItem, 0, len(items))
+	for _, item := range items {
+		modelItem := model.TaskItem{
+			ID:             uint(item.Id),
+			Name:           item.Name,
+			Type:           item.Type,
+			Params:         make(map[string]interface{}, len(item.Params)),
+			TimeoutSeconds: int(item.TimeoutSeconds),
+			Retries:        int(item.Retries),
+			RetryBackoff:   int(item.RetryBackoff),
+			When:           item.When,
+			Weight:         item.Weight,
+			HistoryKey:     item.HistoryKey,
+		}
+
+		// 转换参数
+		for k, v := range item.Params {
+			modelItem.Params[k] = v
+		}
+
+		// 转换依赖关系
+		for _, dep := range item.DependsOn {
+			modelItem.DependsOn = append(modelItem.DependsOn, uint(dep))
+		}
+
+		// 转换重试条件
+		for _, condition := range item.RetryOn {
+			modelItem.RetryOn = append(modelItem.RetryOn, model.RetryCondition(condition))
+		}
+
+		modelItems = append(modelItems, modelItem)
+	}
+	return modelItems
+}
+
+// convertTaskStatus 转换任务状态
+func convertTaskStatus(status model.TaskStatus) candyAgent.TaskStatus {
+	switch status {
+	case model.TaskStatusPending:
+		return candyAgent.TaskStatus_TASK_STATUS_PENDING
+	case model.TaskStatusRunning:
+		return candyAgent.TaskStatus_TASK_STATUS_RUNNING
+	case model.TaskStatusCompleted:
+		return candyAgent.TaskStatus_TASK_STATUS_COMPLETED
+	case model.TaskStatusFailed:
+		return candyAgent.TaskStatus_TASK_STATUS_FAILED
+	case model.TaskStatusCanceled:
+		return candyAgent.TaskStatus_TASK_STATUS_CANCELED
+	default:
+		return candyAgent.TaskStatus_TASK_STATUS_UNKNOWN
+	}
+}
+
+// convertResultStatus 转换结果状态
+func convertResultStatus(status model.ResultStatus) candyAgent.ResultStatus {
+	switch status {
+	case model.ResultStatusNormal:
+		return candyAgent.ResultStatus_RESULT_STATUS_NORMAL
+	case model.ResultStatusWarning:
+		return candyAgent.ResultStatus_RESULT_STATUS_WARNING
+	case model.ResultStatusCritical:
+		return candyAgent.ResultStatus_RESULT_STATUS_FAILED // 将CRITICAL映射到FAILED
+	case model.ResultStatusFailed:
+		return candyAgent.ResultStatus_RESULT_STATUS_FAILED
+	case model.ResultStatusSkipped:
+		return candyAgent.ResultStatus_RESULT_STATUS_SKIPPED
+	default:
+		return candyAgent.ResultStatus_RESULT_STATUS_UNKNOWN
+	}
+}
+
+// convertTaskResults 转换任务结果列表
+func convertTaskResults(results []model.TaskResult) []*candyAgent.TaskResult {
+	converted := make([]*candyAgent.TaskResult, 0, len(results))
+	for _, result := range results {
+		convertedResult := &candyAgent.TaskResult{
+			ItemId:         int64(result.ItemID),
+			Status:         convertResultStatus(result.Status),
+			Message:        result.Message,
+			Details:        result.Details,
+			Duration:       result.Duration,
+			Value:          result.Value,
+			Attempts:       convertTaskAttempts(result.Attempts),
+			Labels:         result.Labels,
+			Transition:     string(result.Transition),
+			PreviousStatus: string(result.PreviousStatus),
+			PreviousValue:  result.PreviousValue,
+			WaitTime:       result.WaitTime,
+		}
+		if result.Delta != nil {
+			delta := *result.Delta
+			convertedResult.Delta = &delta
+		}
+		converted = append(converted, convertedResult)
+	}
+	return converted
+}
+
+// convertTaskAttempts 转换任务项执行记录
+func convertTaskAttempts(attempts []model.TaskAttempt) []*candyAgent.TaskAttempt {
+	if len(attempts) == 0 {
+		return nil
+	}
+	converted := make([]*candyAgent.TaskAttempt, 0, len(attempts))
+	for _, attempt := range attempts {
+		converted = append(converted, &candyAgent.TaskAttempt{
+			Attempt:   int32(attempt.Attempt),
+			Status:    convertResultStatus(attempt.Status),
+			Message:   attempt.Message,
+			Error:     attempt.Error,
+			StartTime: attempt.StartTime.Format(time.RFC3339),
+			Duration:  attempt.Duration,
+		})
+	}
+	return converted
+}
+
+// buildTaskResponse 根据任务对象构建任务响应
+func buildTaskResponse(task *model.Task) *candyAgent.TaskResponse {
+	resp := &candyAgent.TaskResponse{
+		TaskId:    task.ID,
+		Status:    convertTaskStatus(task.Status),
+		Results:   convertTaskResults(task.Results),
+		StartTime: task.StartTime.Format(time.RFC3339),
+		Summary:   convertTaskHealth(summarizeTaskHealth(task)),
+	}
+
+	if !task.EndTime.IsZero() {
+		resp.EndTime = task.EndTime.Format(time.RFC3339)
+	}
+
+	switch {
+	case task.Error != "":
+		resp.Message = task.Error
+	case task.Status == model.TaskStatusPending || task.Status == model.TaskStatusRunning:
+		resp.Message = "Task accepted"
+	case hasFailedResult(task.Results):
+		resp.Message = "Task completed with some failures"
+	default:
+		resp.Message = fmt.Sprintf("Task completed in %d ms", task.EndTime.Sub(task.StartTime).Milliseconds())
+	}
+
+	return resp
+}
+
+// convertCallbackRecord 转换回调记录
+func convertCallbackRecord(record *model.CallbackRecord) *candyAgent.CallbackRecord {
+	converted := &candyAgent.CallbackRecord{
+		TaskId:     record.TaskID,
+		TaskStatus: convertTaskStatus(record.Payload.Status),
+		State:      string(record.State),
+		Attempts:   int32(record.Attempts),
+		LastError:  record.LastError,
+		CreatedAt:  record.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:  record.UpdatedAt.Format(time.RFC3339),
+	}
+	if record.State == model.CallbackStatePending {
+		converted.NextAttempt = record.NextAttempt.Format(time.RFC3339)
+	}
+	return converted
+}
+
+// buildTaskStatusResponse 根据任务对象构建任务状态响应，包含排队信息和执行进度
+func buildTaskStatusResponse(task *model.Task) *candyAgent.TaskStatusResponse {
+	resp := &candyAgent.TaskStatusResponse{
+		TaskId:         task.ID,
+		Status:         convertTaskStatus(task.Status),
+		StartTime:      task.StartTime.Format(time.RFC3339),
+		Message:        task.Error,
+		CompletedItems: int32(len(task.Results)),
+		TotalItems:     int32(len(task.Items)),
+	}
+
+	// 排队信息，已开始执行的任务返回实际等待时间
+	position, length := GetTaskManager().QueuePosition(task.ID)
+	resp.QueuePosition = int32(position)
+	resp.QueueLength = int32(length)
+	switch {
+	case !task.ExecuteTime.IsZero():
+		resp.WaitTime = task.ExecuteTime.Sub(task.StartTime).Milliseconds()
+	case position > 0:
+		resp.WaitTime = time.Since(task.StartTime).Milliseconds()
+	}
+
+	return resp
+}
+
+// convertTaskHealth 转换任务健康汇总
+func convertTaskHealth(health model.TaskHealth) *candyAgent.TaskHealth {
+	converted := &candyAgent.TaskHealth{
+		Counts:      make(map[string]int32, len(health.Counts)),
+		WorstStatus: string(health.WorstStatus),
+		Score:       health.Score,
+	}
+	for status, count := range health.Counts {
+		converted.Counts[string(status)] = int32(count)
+	}
+	for _, failure := range health.TopFailures {
+		converted.TopFailures = append(converted.TopFailures, &candyAgent.TaskFailure{
+			ItemId:  int64(failure.ItemID),
+			Name:    failure.Name,
+			Status:  string(failure.Status),
+			Message: failure.Message,
+			Weight:  failure.Weight,
+		})
+	}
+	return converted
+}
+
+// hasFailedResult 检查是否有任务项执行失败
+func hasFailedResult(results []model.TaskResult) bool {
+	for _, result := range results {
+		if result.Status == model.ResultStatusFailed {
+			return true
+		}
+	}
+	return false
+}
+
+// convertDryRunItem 转换任务项试运行结果
+func convertDryRunItem(item DryRunItem) *candyAgent.DryRunItem {
+	return &candyAgent.DryRunItem{
+		Item:          convertModelTaskItems([]model.TaskItem{item.Item})[0],
+		Valid:         len(item.Errors) == 0,
+		Errors:        item.Errors,
+		ProbeStatus:   string(item.ProbeStatus),
+		ProbeMessage:  item.ProbeMessage,
+		ProbeDuration: item.ProbeDuration,
+	}
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+)
+
+func TestConvertTaskResultsDelta(t *testing.T) {
+	zero := 0.0
+	tests := []struct {
+		name  string
+		delta *float64
+		want  string
+	}{
+		{name: "no previous numeric value", delta: nil},
+		{name: "zero delta", delta: &zero, want: `"delta":0`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			converted := convertTaskResults([]model.TaskResult{{ItemID: 1, Status: model.ResultStatusNormal, Delta: tt.delta}})
+			data, err := json.Marshal(converted[0])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.want == "" {
+				if strings.Contains(string(data), `"delta"`) {
+					t.Errorf("result = %s, want no delta", data)
+				}
+				return
+			}
+			if !strings.Contains(string(data), tt.want) {
+				t.Errorf("result = %s, want %s", data, tt.want)
+			}
+		})
+	}
+}
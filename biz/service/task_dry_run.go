@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.mokaz111.com/candy-agent/biz/executor"
+	"github.mokaz111.com/candy-agent/biz/model"
+)
+
+// ProbeStatus 试运行时目标连通性检查结果
+type ProbeStatus string
+
+const (
+	// ProbeReachable 目标可达
+	ProbeReachable ProbeStatus = "reachable"
+	// ProbeUnreachable 目标不可达
+	ProbeUnreachable ProbeStatus = "unreachable"
+	// ProbeUnsupported 执行器不支持连通性检查
+	ProbeUnsupported ProbeStatus = "unsupported"
+	// ProbeSkipped 任务项校验失败或目标在执行时才能确定，未检查连通性
+	ProbeSkipped ProbeStatus = "skipped"
+)
+
+// secretMask 试运行结果中敏感参数的展示值
+const secretMask = "******"
+
+// DryRunItem 任务项试运行结果
+type DryRunItem struct {
+	Item          model.TaskItem // 补齐默认值后的任务项，敏感参数已隐藏
+	Errors        []string       // 校验错误
+	ProbeStatus   ProbeStatus
+	ProbeMessage  string
+	ProbeDuration int64 // 连通性检查耗时(毫秒)
+}
+
+// DryRun 试运行任务：校验任务项依赖关系、重试配置、权重和参数，并检查每个任务项的目标是否可达，
+// 不创建任务，也不执行查询或命令。依赖关系无效时返回错误，任务项的问题记录在对应的结果中
+func (tm *TaskManager) DryRun(ctx context.Context, task *model.Task) ([]DryRunItem, error) {
+	if _, err := buildTaskGraph(task.Items); err != nil {
+		return nil, err
+	}
+
+	items := copyTaskItems(task.Items)
+	results := make([]DryRunItem, len(items))
+	index := make(map[uint]int, len(items))
+	for i, item := range items {
+		index[item.ID] = i
+		if err := validateRetryPolicy(item); err != nil {
+			results[i].Errors = append(results[i].Errors, err.Error())
+		}
+		if err := validateItemWeight(item); err != nil {
+			results[i].Errors = append(results[i].Errors, err.Error())
+		}
+	}
+
+	var paramErr *TaskParamError
+	if err := tm.validateTaskItemParams(items); errors.As(err, &paramErr) {
+		for _, itemErr := range paramErr.Items {
+			i := index[itemErr.ItemID]
+			results[i].Errors = append(results[i].Errors, itemErr.Errors...)
+		}
+	}
+
+	parallelism := task.Parallelism
+	if parallelism <= 0 {
+		parallelism = tm.itemParallelism
+	}
+	semaphore := make(chan struct{}, parallelism)
+
+	var wg sync.WaitGroup
+	for i := range items {
+		results[i].Item = items[i]
+		if len(results[i].Errors) > 0 {
+			results[i].ProbeStatus = ProbeSkipped
+			continue
+		}
+		if len(deferredParams(items[i])) > 0 {
+			results[i].ProbeStatus = ProbeSkipped
+			results[i].ProbeMessage = "参数引用了其他任务项的结果，执行时才能确定目标"
+			continue
+		}
+
+		wg.Add(1)
+		go func(result *DryRunItem) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+			tm.probeTaskItem(ctx, result)
+		}(&results[i])
+	}
+	wg.Wait()
+
+	for i := range results {
+		results[i].Item = tm.maskSecretParams(results[i].Item)
+	}
+	return results, nil
+}
+
+// probeTaskItem 检查任务项的目标是否可达
+func (tm *TaskManager) probeTaskItem(ctx context.Context, result *DryRunItem) {
+	item := result.Item
+	exec, err := tm.executorFactory.Create(item.Type)
+	if err != nil {
+		result.ProbeStatus = ProbeSkipped
+		result.ProbeMessage = err.Error()
+		return
+	}
+
+	prober, ok := exec.(executor.Prober)
+	if !ok {
+		result.ProbeStatus = ProbeUnsupported
+		result.ProbeMessage = fmt.Sprintf("执行器 %s 不支持连通性检查", item.Type)
+		return
+	}
+
+	startTime := time.Now()
+	err = prober.Probe(ctx, item)
+	result.ProbeDuration = time.Since(startTime).Milliseconds()
+	if err != nil {
+		result.ProbeStatus = ProbeUnreachable
+		result.ProbeMessage = err.Error()
+		return
+	}
+	result.ProbeStatus = ProbeReachable
+	result.ProbeMessage = "目标可达"
+}
+
+// maskSecretParams 隐藏执行器声明为敏感的参数值，参数复制后再修改，不影响原任务项
+func (tm *TaskManager) maskSecretParams(item model.TaskItem) model.TaskItem {
+	exec, err := tm.executorFactory.Create(item.Type)
+	if err != nil {
+		return item
+	}
+	schema := exec.Schema()
+	params := make(map[string]interface{}, len(item.Params))
+	for key, value := range item.Params {
+		if value != "" && schema.IsSecret(key) {
+			value = secretMask
+		}
+		params[key] = value
+	}
+	item.Params = params
+	return item
+}
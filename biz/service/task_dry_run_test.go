@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.mokaz111.com/candy-agent/biz/executor"
+	"github.mokaz111.com/candy-agent/biz/model"
+)
+
+// probeExecutor 支持连通性检查的测试执行器，host 为 down 时目标不可达
+type probeExecutor struct{}
+
+func (probeExecutor) Name() string { return "probe" }
+
+func (probeExecutor) Schema() executor.Schema {
+	return executor.Schema{Name: "probe", Params: []executor.ParamSchema{
+		{Name: "host", Type: executor.ParamTypeString, Required: true},
+		{Name: "port", Type: executor.ParamTypeInteger, Default: "22"},
+		{Name: "password", Type: executor.ParamTypeString, Secret: true},
+	}}
+}
+
+func (probeExecutor) Execute(ctx context.Context, item model.TaskItem) (model.TaskResult, error) {
+	return model.TaskResult{ItemID: item.ID, Status: model.ResultStatusNormal}, nil
+}
+
+func (probeExecutor) Probe(ctx context.Context, item model.TaskItem) error {
+	if item.Params["host"] == "down" {
+		return fmt.Errorf("dial %s: connection refused", item.Params["host"])
+	}
+	return nil
+}
+
+// newDryRunTaskManager 创建只注册测试执行器的任务管理器，不使用全局执行器工厂
+func newDryRunTaskManager() *TaskManager {
+	factory := executor.NewExecutorFactory()
+	factory.Register(probeExecutor{})
+	factory.Register(fakeExecutor{})
+	return &TaskManager{executorFactory: factory, itemParallelism: 2}
+}
+
+func TestDryRun(t *testing.T) {
+	tm := newDryRunTaskManager()
+	task := &model.Task{Items: []model.TaskItem{
+		{ID: 1, Name: "reachable", Type: "probe", Params: map[string]interface{}{"host": "db-1", "password": "s3cret"}},
+		{ID: 2, Name: "unreachable", Type: "probe", Params: map[string]interface{}{"host": "down"}},
+		{ID: 3, Name: "invalid", Type: "probe", Params: map[string]interface{}{"port": "ssh", "password": "s3cret"}},
+		{ID: 4, Name: "deferred", Type: "probe", Params: map[string]interface{}{"host": "{{items.1.labels.instance}}"}},
+		{ID: 5, Name: "unsupported", Type: "fake"},
+		{ID: 6, Name: "unknown", Type: "missing"},
+	}}
+
+	results, err := tm.DryRun(context.Background(), task)
+	if err != nil {
+		t.Fatalf("DryRun() error = %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		wantStatus  ProbeStatus
+		wantErrors  []string
+		wantMessage string
+	}{
+		{name: "reachable", wantStatus: ProbeReachable, wantMessage: "目标可达"},
+		{name: "unreachable", wantStatus: ProbeUnreachable, wantMessage: "connection refused"},
+		{name: "invalid", wantStatus: ProbeSkipped, wantErrors: []string{"缺少必填参数 host", "参数 port 应为整数"}},
+		{name: "deferred", wantStatus: ProbeSkipped, wantMessage: "执行时才能确定目标"},
+		{name: "unsupported", wantStatus: ProbeUnsupported, wantMessage: "执行器 fake 不支持连通性检查"},
+		{name: "unknown", wantStatus: ProbeSkipped, wantErrors: []string{"不支持的执行器类型: missing"}},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := results[i]
+			if result.Item.Name != tt.name {
+				t.Fatalf("result %d is item %q, want %q", i, result.Item.Name, tt.name)
+			}
+			if result.ProbeStatus != tt.wantStatus {
+				t.Errorf("probe status = %s, want %s", result.ProbeStatus, tt.wantStatus)
+			}
+			if !strings.Contains(result.ProbeMessage, tt.wantMessage) {
+				t.Errorf("probe message = %q, want %q", result.ProbeMessage, tt.wantMessage)
+			}
+			if len(result.Errors) != len(tt.wantErrors) {
+				t.Fatalf("errors = %q, want %q", result.Errors, tt.wantErrors)
+			}
+			for j, want := range tt.wantErrors {
+				if !strings.Contains(result.Errors[j], want) {
+					t.Errorf("errors[%d] = %q, want %q", j, result.Errors[j], want)
+				}
+			}
+		})
+	}
+}
+
+func TestDryRunMasksSecretParams(t *testing.T) {
+	tm := newDryRunTaskManager()
+	task := &model.Task{Items: []model.TaskItem{
+		{ID: 1, Name: "db", Type: "probe", Params: map[string]interface{}{"host": "db-1", "password": "s3cret"}},
+		{ID: 2, Name: "empty secret", Type: "probe", Params: map[string]interface{}{"host": "db-2", "password": ""}},
+	}}
+
+	results, err := tm.DryRun(context.Background(), task)
+	if err != nil {
+		t.Fatalf("DryRun() error = %v", err)
+	}
+
+	params := results[0].Item.Params
+	if params["password"] != secretMask {
+		t.Errorf("password = %v, want masked", params["password"])
+	}
+	if params["host"] != "db-1" || params["port"] != "22" {
+		t.Errorf("params = %v, want host kept and default port filled", params)
+	}
+	if got := results[1].Item.Params["password"]; got != "" {
+		t.Errorf("empty password = %v, want empty", got)
+	}
+
+	// 调用方的任务项既不被隐藏也不被补齐默认值
+	original := task.Items[0].Params
+	if original["password"] != "s3cret" {
+		t.Errorf("caller password changed to %v", original["password"])
+	}
+	if _, ok := original["port"]; ok || len(original) != 2 {
+		t.Errorf("caller params changed to %v", original)
+	}
+}
+
+func TestDryRunInvalidGraph(t *testing.T) {
+	tm := newDryRunTaskManager()
+	task := &model.Task{Items: []model.TaskItem{
+		{ID: 1, Name: "a", Type: "probe", DependsOn: []uint{2}},
+		{ID: 2, Name: "b", Type: "probe", DependsOn: []uint{1}},
+	}}
+	if _, err := tm.DryRun(context.Background(), task); err == nil || !strings.Contains(err.Error(), "循环依赖") {
+		t.Fatalf("DryRun() error = %v, want cycle error", err)
+	}
+}
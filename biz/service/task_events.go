@@ -0,0 +1,79 @@
+package service
+
+import (
+	"sync"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+)
+
+// TaskEventType 任务事件类型
+type TaskEventType string
+
+const (
+	// TaskEventStatus 任务状态变化
+	TaskEventStatus TaskEventType = "status"
+	// TaskEventResult 任务项执行完成
+	TaskEventResult TaskEventType = "result"
+	// TaskEventDone 任务结束，之后不再有事件
+	TaskEventDone TaskEventType = "done"
+)
+
+// TaskEvent 任务执行事件
+type TaskEvent struct {
+	Type   TaskEventType
+	Status model.TaskStatus  // status 事件的任务状态
+	Result *model.TaskResult // result 事件的任务项结果
+}
+
+// taskEventBufferSize 每个订阅者的事件缓冲数，缓冲满时丢弃事件，结束事件由订阅方根据 Task.Done 补发
+const taskEventBufferSize = 64
+
+// taskEventHub 任务事件分发器，按任务ID分发执行过程中的事件
+type taskEventHub struct {
+	subscribers map[string]map[chan TaskEvent]struct{}
+	mutex       sync.Mutex
+}
+
+// newTaskEventHub 创建任务事件分发器
+func newTaskEventHub() *taskEventHub {
+	return &taskEventHub{
+		subscribers: make(map[string]map[chan TaskEvent]struct{}),
+	}
+}
+
+// subscribe 订阅任务事件，返回事件通道和取消订阅函数
+func (h *taskEventHub) subscribe(taskID string) (<-chan TaskEvent, func()) {
+	ch := make(chan TaskEvent, taskEventBufferSize)
+
+	h.mutex.Lock()
+	if h.subscribers[taskID] == nil {
+		h.subscribers[taskID] = make(map[chan TaskEvent]struct{})
+	}
+	h.subscribers[taskID][ch] = struct{}{}
+	h.mutex.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			h.mutex.Lock()
+			defer h.mutex.Unlock()
+			delete(h.subscribers[taskID], ch)
+			if len(h.subscribers[taskID]) == 0 {
+				delete(h.subscribers, taskID)
+			}
+		})
+	}
+}
+
+// publish 向任务的所有订阅者发送事件，订阅者处理不及时时丢弃
+func (h *taskEventHub) publish(taskID string, event TaskEvent) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	for ch := range h.subscribers[taskID] {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+)
+
+// taskGraph 任务项依赖图
+type taskGraph struct {
+	items      []model.TaskItem
+	index      map[uint]int     // 任务项ID -> 下标
+	dependsOn  [][]int          // 每个任务项依赖的任务项下标
+	conditions []*taskCondition // 每个任务项的执行条件，未设置时为 nil
+}
+
+// buildTaskGraph 构建任务项依赖图，校验重复ID、未知依赖和循环依赖。
+// 执行条件和参数中引用的任务项会作为隐式依赖，保证引用的结果已经产生
+func buildTaskGraph(items []model.TaskItem) (*taskGraph, error) {
+	graph := &taskGraph{
+		items:      items,
+		index:      make(map[uint]int, len(items)),
+		dependsOn:  make([][]int, len(items)),
+		conditions: make([]*taskCondition, len(items)),
+	}
+
+	for i, item := range items {
+		if _, exists := graph.index[item.ID]; exists {
+			return nil, fmt.Errorf("任务项ID重复: %d", item.ID)
+		}
+		graph.index[item.ID] = i
+	}
+
+	for i, item := range items {
+		for _, depID := range item.DependsOn {
+			if depID == item.ID {
+				return nil, fmt.Errorf("任务项 %d 不能依赖自身", item.ID)
+			}
+			dep, exists := graph.index[depID]
+			if !exists {
+				return nil, fmt.Errorf("任务项 %d 依赖的任务项 %d 不存在", item.ID, depID)
+			}
+			graph.dependsOn[i] = append(graph.dependsOn[i], dep)
+		}
+
+		// 执行条件和参数中引用的任务项作为隐式依赖
+		var refs []uint
+		if strings.TrimSpace(item.When) != "" {
+			condition, err := parseTaskCondition(item.When)
+			if err != nil {
+				return nil, fmt.Errorf("任务项 %d 的执行条件无效: %v", item.ID, err)
+			}
+			graph.conditions[i] = condition
+			refs = append(refs, condition.refs...)
+		}
+		paramRefs, _, err := parseParamRefs(item)
+		if err != nil {
+			return nil, fmt.Errorf("任务项 %d 的参数引用无效: %v", item.ID, err)
+		}
+		refs = append(refs, paramRefs...)
+
+		for _, refID := range refs {
+			if refID == item.ID {
+				return nil, fmt.Errorf("任务项 %d 不能引用自身的结果", item.ID)
+			}
+			dep, exists := graph.index[refID]
+			if !exists {
+				return nil, fmt.Errorf("任务项 %d 引用的任务项 %d 不存在", item.ID, refID)
+			}
+			if !containsIndex(graph.dependsOn[i], dep) {
+				graph.dependsOn[i] = append(graph.dependsOn[i], dep)
+			}
+		}
+	}
+
+	if cycle := graph.findCycle(); len(cycle) > 0 {
+		ids := make([]string, 0, len(cycle))
+		for _, i := range cycle {
+			ids = append(ids, fmt.Sprintf("%d", items[i].ID))
+		}
+		return nil, fmt.Errorf("任务项存在循环依赖: %s", strings.Join(ids, ", "))
+	}
+
+	return graph, nil
+}
+
+// findCycle 使用拓扑排序检测循环依赖，返回无法排序的任务项下标
+func (g *taskGraph) findCycle() []int {
+	inDegree := make([]int, len(g.items))
+	dependents := make([][]int, len(g.items))
+	for i, deps := range g.dependsOn {
+		inDegree[i] = len(deps)
+		for _, dep := range deps {
+			dependents[dep] = append(dependents[dep], i)
+		}
+	}
+
+	queue := make([]int, 0, len(g.items))
+	for i, degree := range inDegree {
+		if degree == 0 {
+			queue = append(queue, i)
+		}
+	}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, next := range dependents[current] {
+			inDegree[next]--
+			if inDegree[next] == 0 {
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	var remaining []int
+	for i, degree := range inDegree {
+		if degree > 0 {
+			remaining = append(remaining, i)
+		}
+	}
+	sort.Ints(remaining)
+	return remaining
+}
+
+// containsIndex 判断下标列表中是否包含指定下标
+func containsIndex(indexes []int, target int) bool {
+	for _, index := range indexes {
+		if index == target {
+			return true
+		}
+	}
+	return false
+}
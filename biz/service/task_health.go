@@ -0,0 +1,116 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+)
+
+// maxTopFailures 健康汇总中列出的异常任务项数量上限
+const maxTopFailures = 5
+
+// resultSeverity 结果状态的严重程度，数值越大越严重，跳过的任务项不参与比较
+var resultSeverity = map[model.ResultStatus]int{
+	model.ResultStatusSkipped:  0,
+	model.ResultStatusNormal:   1,
+	model.ResultStatusWarning:  2,
+	model.ResultStatusCritical: 3,
+	model.ResultStatusFailed:   4,
+}
+
+// resultScore 结果状态对应的健康分，正常为1，警告为0.5，严重和失败为0
+var resultScore = map[model.ResultStatus]float64{
+	model.ResultStatusNormal:   1,
+	model.ResultStatusWarning:  0.5,
+	model.ResultStatusCritical: 0,
+	model.ResultStatusFailed:   0,
+}
+
+// itemWeight 任务项的健康评分权重，未设置时为1
+func itemWeight(item model.TaskItem) float64 {
+	if item.Weight <= 0 {
+		return 1
+	}
+	return item.Weight
+}
+
+// validateItemWeight 校验任务项的健康评分权重
+func validateItemWeight(item model.TaskItem) error {
+	if math.IsNaN(item.Weight) || math.IsInf(item.Weight, 0) || item.Weight < 0 {
+		return fmt.Errorf("任务项 %d 的权重必须是非负数", item.ID)
+	}
+	return nil
+}
+
+// summarizeTaskHealth 根据任务项结果计算任务健康汇总。
+// 健康分为任务项按权重加权的平均分(0-100)，没有结果的任务项按0分计入，跳过的任务项不计分，
+// 没有可计分的任务项时不计算健康分
+func summarizeTaskHealth(task *model.Task) model.TaskHealth {
+	items := make(map[uint]model.TaskItem, len(task.Items))
+	for _, item := range task.Items {
+		items[item.ID] = item
+	}
+
+	health := model.TaskHealth{
+		Counts: make(map[model.ResultStatus]int),
+	}
+
+	var totalWeight, totalScore float64
+	finished := make(map[uint]bool, len(task.Results))
+	for _, result := range task.Results {
+		finished[result.ItemID] = true
+		health.Counts[result.Status]++
+		if health.WorstStatus == "" || resultSeverity[result.Status] > resultSeverity[health.WorstStatus] {
+			health.WorstStatus = result.Status
+		}
+
+		score, scored := resultScore[result.Status]
+		if !scored {
+			continue
+		}
+		item := items[result.ItemID]
+		weight := itemWeight(item)
+		totalWeight += weight
+		totalScore += weight * score
+
+		if result.Status != model.ResultStatusNormal {
+			health.TopFailures = append(health.TopFailures, model.TaskFailure{
+				ItemID:  result.ItemID,
+				Name:    item.Name,
+				Status:  result.Status,
+				Message: result.Message,
+				Weight:  weight,
+			})
+		}
+	}
+
+	// 未执行完或任务失败、中断后没有结果的任务项按0分计入，避免健康分虚高
+	for _, item := range task.Items {
+		if !finished[item.ID] {
+			totalWeight += itemWeight(item)
+		}
+	}
+
+	if totalWeight > 0 {
+		score := math.Round(totalScore/totalWeight*10000) / 100
+		health.Score = &score
+	}
+
+	// 按严重程度、权重从高到低排列，只保留最严重的几个
+	sort.SliceStable(health.TopFailures, func(i, j int) bool {
+		a, b := health.TopFailures[i], health.TopFailures[j]
+		if resultSeverity[a.Status] != resultSeverity[b.Status] {
+			return resultSeverity[a.Status] > resultSeverity[b.Status]
+		}
+		if a.Weight != b.Weight {
+			return a.Weight > b.Weight
+		}
+		return a.ItemID < b.ItemID
+	})
+	if len(health.TopFailures) > maxTopFailures {
+		health.TopFailures = health.TopFailures[:maxTopFailures]
+	}
+	return health
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+)
+
+func TestSummarizeTaskHealth(t *testing.T) {
+	items := func(weights ...float64) []model.TaskItem {
+		taskItems := make([]model.TaskItem, 0, len(weights))
+		for i, weight := range weights {
+			taskItems = append(taskItems, model.TaskItem{ID: uint(i + 1), Weight: weight})
+		}
+		return taskItems
+	}
+	result := func(id uint, status model.ResultStatus) model.TaskResult {
+		return model.TaskResult{ItemID: id, Status: status}
+	}
+
+	tests := []struct {
+		name       string
+		task       model.Task
+		wantScore  *float64
+		wantWorst  model.ResultStatus
+		wantCounts map[model.ResultStatus]int
+	}{
+		{
+			name: "mixed statuses with weights",
+			task: model.Task{Status: model.TaskStatusCompleted, Items: items(3, 1, 2, 0, 1), Results: []model.TaskResult{
+				result(1, model.ResultStatusNormal),
+				result(2, model.ResultStatusWarning),
+				result(3, model.ResultStatusCritical),
+				result(4, model.ResultStatusNormal),
+				result(5, model.ResultStatusSkipped),
+			}},
+			// (3*1 + 1*0.5 + 2*0 + 1*1) / 7
+			wantScore:  float64Ptr(64.29),
+			wantWorst:  model.ResultStatusCritical,
+			wantCounts: map[model.ResultStatus]int{"normal": 2, "warning": 1, "critical": 1, "skipped": 1},
+		},
+		{
+			name:       "zero results counts pending items as zero",
+			task:       model.Task{Status: model.TaskStatusRunning, Items: items(1, 1)},
+			wantScore:  float64Ptr(0),
+			wantCounts: map[model.ResultStatus]int{},
+		},
+		{
+			name:       "no items is not scored",
+			task:       model.Task{Status: model.TaskStatusCompleted},
+			wantCounts: map[model.ResultStatus]int{},
+		},
+		{
+			name: "all items skipped is not scored",
+			task: model.Task{Status: model.TaskStatusCompleted, Items: items(1, 2), Results: []model.TaskResult{
+				result(1, model.ResultStatusSkipped),
+				result(2, model.ResultStatusSkipped),
+			}},
+			wantWorst:  model.ResultStatusSkipped,
+			wantCounts: map[model.ResultStatus]int{"skipped": 2},
+		},
+		{
+			name: "failed task counts missing items as zero",
+			task: model.Task{Status: model.TaskStatusFailed, Items: items(1, 1, 2), Results: []model.TaskResult{
+				result(1, model.ResultStatusNormal),
+				result(2, model.ResultStatusFailed),
+			}},
+			wantScore:  float64Ptr(25),
+			wantWorst:  model.ResultStatusFailed,
+			wantCounts: map[model.ResultStatus]int{"normal": 1, "failed": 1},
+		},
+		{
+			name: "all normal",
+			task: model.Task{Status: model.TaskStatusCompleted, Items: items(1, 5), Results: []model.TaskResult{
+				result(1, model.ResultStatusNormal),
+				result(2, model.ResultStatusNormal),
+			}},
+			wantScore:  float64Ptr(100),
+			wantWorst:  model.ResultStatusNormal,
+			wantCounts: map[model.ResultStatus]int{"normal": 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			health := summarizeTaskHealth(&tt.task)
+			switch {
+			case tt.wantScore == nil && health.Score != nil:
+				t.Errorf("score = %v, want not scored", *health.Score)
+			case tt.wantScore != nil && health.Score == nil:
+				t.Errorf("score not set, want %v", *tt.wantScore)
+			case tt.wantScore != nil && *health.Score != *tt.wantScore:
+				t.Errorf("score = %v, want %v", *health.Score, *tt.wantScore)
+			}
+			if health.WorstStatus != tt.wantWorst {
+				t.Errorf("worst status = %q, want %q", health.WorstStatus, tt.wantWorst)
+			}
+			if !reflect.DeepEqual(health.Counts, tt.wantCounts) {
+				t.Errorf("counts = %v, want %v", health.Counts, tt.wantCounts)
+			}
+		})
+	}
+}
+
+func TestSummarizeTaskHealthTopFailures(t *testing.T) {
+	task := &model.Task{
+		Items: []model.TaskItem{
+			{ID: 1, Name: "warning light", Weight: 1},
+			{ID: 2, Name: "critical light", Weight: 1},
+			{ID: 3, Name: "warning heavy", Weight: 3},
+			{ID: 4, Name: "failed"},
+			{ID: 5, Name: "critical heavy", Weight: 2},
+			{ID: 6, Name: "normal"},
+			{ID: 7, Name: "critical light 2"},
+			{ID: 8, Name: "skipped"},
+		},
+		Results: []model.TaskResult{
+			{ItemID: 1, Status: model.ResultStatusWarning},
+			{ItemID: 2, Status: model.ResultStatusCritical},
+			{ItemID: 3, Status: model.ResultStatusWarning},
+			{ItemID: 4, Status: model.ResultStatusFailed, Message: "connection refused"},
+			{ItemID: 5, Status: model.ResultStatusCritical},
+			{ItemID: 6, Status: model.ResultStatusNormal},
+			{ItemID: 7, Status: model.ResultStatusCritical},
+			{ItemID: 8, Status: model.ResultStatusSkipped},
+		},
+	}
+
+	health := summarizeTaskHealth(task)
+	got := make([]uint, 0, len(health.TopFailures))
+	for _, failure := range health.TopFailures {
+		got = append(got, failure.ItemID)
+	}
+	// 按严重程度、权重、任务项ID排序，最多保留 maxTopFailures 个
+	if want := []uint{4, 5, 2, 7, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("top failures = %v, want %v", got, want)
+	}
+	if first := health.TopFailures[0]; first.Name != "failed" || first.Message != "connection refused" || first.Weight != 1 {
+		t.Errorf("top failure = %+v", first)
+	}
+}
+
+func TestValidateItemWeight(t *testing.T) {
+	tests := []struct {
+		weight  float64
+		wantErr bool
+	}{
+		{weight: 0},
+		{weight: 2.5},
+		{weight: -1, wantErr: true},
+		{weight: math.NaN(), wantErr: true},
+		{weight: math.Inf(1), wantErr: true},
+		{weight: math.Inf(-1), wantErr: true},
+	}
+	for _, tt := range tests {
+		err := validateItemWeight(model.TaskItem{ID: 1, Weight: tt.weight})
+		if tt.wantErr != (err != nil) {
+			t.Errorf("validateItemWeight(%v) error = %v, want error %v", tt.weight, err, tt.wantErr)
+		}
+		if err != nil && !strings.Contains(err.Error(), "权重必须是非负数") {
+			t.Errorf("validateItemWeight(%v) error = %v", tt.weight, err)
+		}
+	}
+}
+
+func TestCreateTaskRejectsInvalidWeight(t *testing.T) {
+	taskID := testTaskID(t)
+	item := fakeItem(taskID, 1, "weighted", nil)
+	item.Weight = -2
+	if _, err := GetTaskManager().CreateTask(&model.Task{ID: taskID, Items: []model.TaskItem{item}}); err == nil || !strings.Contains(err.Error(), "权重") {
+		t.Fatalf("CreateTask() error = %v, want weight error", err)
+	}
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+)
+
+// ErrIdempotencyConflict 同一幂等键提交了不同的任务内容
+var ErrIdempotencyConflict = errors.New("幂等键冲突")
+
+// taskPayload 参与幂等校验的任务内容，执行模式只影响响应方式，不参与校验
+type taskPayload struct {
+	ID          string           `json:"task_id"`
+	Items       []model.TaskItem `json:"items"`
+	Timeout     int              `json:"timeout"`
+	Parallelism int              `json:"parallelism"`
+	Priority    int              `json:"priority"`
+}
+
+// taskPayloadHash 计算任务内容摘要，参数 map 序列化时按键排序，结果与字段顺序无关
+func taskPayloadHash(task *model.Task) (string, error) {
+	data, err := json.Marshal(taskPayload{
+		ID:          task.ID,
+		Items:       task.Items,
+		Timeout:     task.Timeout,
+		Parallelism: task.Parallelism,
+		Priority:    task.Priority,
+	})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+)
+
+func TestSubmitTaskIdempotency(t *testing.T) {
+	tm := GetTaskManager()
+	id := testTaskID(t)
+	newTask := func(taskID, value string) *model.Task {
+		return &model.Task{
+			ID:             taskID,
+			Timeout:        5,
+			IdempotencyKey: id,
+			Items:          []model.TaskItem{fakeItem(id, 1, "item", map[string]interface{}{"value": value})},
+		}
+	}
+
+	first, replayed, err := tm.SubmitTask(newTask(id, "1"))
+	if err != nil || replayed {
+		t.Fatalf("first submit = %v, replayed %v", err, replayed)
+	}
+
+	again, replayed, err := tm.SubmitTask(newTask(id, "1"))
+	if err != nil || !replayed || again.ID != first.ID {
+		t.Fatalf("replay = %v, replayed %v, task %v", err, replayed, again)
+	}
+
+	for name, task := range map[string]*model.Task{
+		"different params":  newTask(id, "2"),
+		"different task id": newTask(id+"-other", "1"),
+	} {
+		if _, _, err := tm.SubmitTask(task); !errors.Is(err, ErrIdempotencyConflict) {
+			t.Errorf("%s: err = %v, want ErrIdempotencyConflict", name, err)
+		}
+	}
+	if _, err := tm.GetTaskSnapshot(id + "-other"); err == nil {
+		t.Fatal("conflicting submit created a task")
+	}
+}
+
+func TestSubmitTaskIdempotencyConcurrent(t *testing.T) {
+	tm := GetTaskManager()
+	id := testTaskID(t)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	created := 0
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, replayed, err := tm.SubmitTask(&model.Task{
+				ID:             id,
+				Timeout:        5,
+				IdempotencyKey: id,
+				Items:          []model.TaskItem{fakeItem(id, 1, "item", nil)},
+			})
+			if err != nil {
+				t.Errorf("SubmitTask: %v", err)
+				return
+			}
+			if !replayed {
+				mu.Lock()
+				created++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if created != 1 {
+		t.Fatalf("%d submits created a task, want 1", created)
+	}
+}
@@ -1,15 +1,13 @@
 package service
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"net/http"
 	"sync"
 	"time"
 
 	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.mokaz111.com/candy-agent/biz/dal/filestore"
 	"github.mokaz111.com/candy-agent/biz/executor"
 	"github.mokaz111.com/candy-agent/biz/model"
 	"github.mokaz111.com/candy-agent/conf"
@@ -19,8 +17,14 @@ import (
 type TaskManager struct {
 	cache           *model.TaskCache
 	executorFactory *executor.ExecutorFactory
-	workerPool      chan struct{} // 控制并发执行的任务数
-	callbackURL     string
+	queue           *taskQueue // 等待执行的任务，由固定数量的工作协程消费
+	itemParallelism int        // 单个任务内任务项的默认并发数
+	events          *taskEventHub
+	idempotencyKeys map[string]string // 幂等键到任务ID的映射
+	resumable       bool              // 任务是否持久化并在重启后恢复执行
+	workers         sync.WaitGroup
+	stopping        chan struct{}    // 关闭时排空超时后关闭，中断仍在执行的任务
+	limiter         *executorLimiter // 按执行器类型和目标限流
 	mutex           sync.RWMutex
 }
 
@@ -37,41 +41,181 @@ func GetTaskManager() *TaskManager {
 			maxWorkers = 10 // 默认最大10个并发任务
 		}
 
+		maxQueueDepth := conf.GetConf().TaskManager.MaxQueueDepth
+		if maxQueueDepth <= 0 {
+			maxQueueDepth = 1000 // 默认最多1000个排队任务
+		}
+
+		itemParallelism := conf.GetConf().TaskManager.ItemParallelism
+		if itemParallelism <= 0 {
+			itemParallelism = 5 // 默认单个任务内最多5个任务项并发
+		}
+
+		store := newTaskStore()
+		_, persistent := store.(*filestore.Store[model.Task])
+
 		taskManagerInstance = &TaskManager{
-			cache:           model.NewTaskCache(),
+			cache:           model.NewTaskCache(store, time.Duration(conf.GetConf().TaskManager.TaskExpiration)*time.Hour),
 			executorFactory: executor.GetExecutorFactory(),
-			workerPool:      make(chan struct{}, maxWorkers),
-			callbackURL:     conf.GetConf().Server.CallbackURL,
+			queue:           newTaskQueue(maxQueueDepth),
+			itemParallelism: itemParallelism,
+			events:          newTaskEventHub(),
+			idempotencyKeys: make(map[string]string),
+			resumable:       persistent && conf.GetConf().TaskManager.RecoverPolicy != "fail",
+			stopping:        make(chan struct{}),
+			limiter:         newExecutorLimiter(conf.GetConf().ExecutorLimits),
+		}
+
+		// 启动工作协程，控制并发执行的任务数
+		taskManagerInstance.workers.Add(maxWorkers)
+		for i := 0; i < maxWorkers; i++ {
+			go taskManagerInstance.worker()
 		}
 	})
 	return taskManagerInstance
 }
 
-// CreateTask 创建并开始执行任务
-func (tm *TaskManager) CreateTask(taskID string, items []model.TaskItem, timeout int) (*model.Task, error) {
+// newTaskStore 根据配置创建任务存储，创建失败时退化为内存存储
+func newTaskStore() model.TaskStore {
+	cfg := conf.GetConf().TaskManager
+	return newStore(storeConfig{name: "任务", kind: cfg.TaskStore, dir: cfg.TaskStoreDir, defaultDir: "data/tasks"},
+		func(task *model.Task) string { return task.ID })
+}
+
+// RecoverTasks 加载已保存的任务，并按配置恢复或终止重启前未完成的任务
+func (tm *TaskManager) RecoverTasks() {
+	tasks, err := tm.cache.LoadTasks()
+	if err != nil {
+		hlog.Errorf("加载已保存的任务失败: %v", err)
+		return
+	}
+
+	policy := conf.GetConf().TaskManager.RecoverPolicy
+	var resumed, failed int
+	for _, task := range tasks {
+		task.Cancel = make(chan struct{})
+		task.Done = make(chan struct{})
+		tm.rememberIdempotencyKey(task)
+
+		if task.Status.IsFinished() {
+			close(task.Done)
+			continue
+		}
+
+		if policy == "fail" {
+			tm.cache.SaveTask(task.ID, func(t *model.Task) {
+				t.Interrupted = true
+				t.Status = model.TaskStatusFailed
+				t.EndTime = time.Now()
+				t.Error = "任务因 Agent 重启中断"
+			})
+			close(task.Done)
+			tm.sendCallback(task.ID)
+			failed++
+			continue
+		}
+
+		// 重新排队执行，已有结果的任务项不会重复执行，恢复的任务不受队列长度限制
+		tm.cache.SaveTask(task.ID, func(t *model.Task) {
+			t.Interrupted = true
+			t.Status = model.TaskStatusPending
+		})
+		tm.queue.Push(task, false)
+		resumed++
+	}
+
+	hlog.Infof("已加载 %d 个任务，恢复执行 %d 个，标记失败 %d 个", len(tasks), resumed, failed)
+}
+
+// CreateTask 创建任务并加入执行队列，task 中只需填写ID、任务项、超时时间、并发数、优先级和来源定时巡检。
+// 返回入队前的任务副本，任务入队后由工作协程修改，调用方需要最新状态时使用 GetTaskSnapshot
+func (tm *TaskManager) CreateTask(task *model.Task) (*model.Task, error) {
 	// 检查任务是否已存在
-	if existingTask, exists := tm.cache.GetTask(taskID); exists {
-		return existingTask, fmt.Errorf("任务已存在: %s", taskID)
+	if _, exists := tm.cache.GetTask(task.ID); exists {
+		return nil, fmt.Errorf("任务已存在: %s", task.ID)
 	}
 
-	// 创建任务对象
-	task := &model.Task{
-		ID:        taskID,
-		Status:    model.TaskStatusPending,
-		Items:     items,
-		Results:   make([]model.TaskResult, 0),
-		StartTime: time.Now(),
-		Timeout:   timeout,
-		Cancel:    make(chan struct{}),
+	// 提交时校验任务项依赖关系、重试配置和执行器参数
+	if _, err := buildTaskGraph(task.Items); err != nil {
+		return nil, err
+	}
+	for _, item := range task.Items {
+		if err := validateRetryPolicy(item); err != nil {
+			return nil, err
+		}
+		if err := validateItemWeight(item); err != nil {
+			return nil, err
+		}
 	}
+	if err := tm.validateTaskItemParams(task.Items); err != nil {
+		return nil, err
+	}
+
+	// 初始化任务运行状态
+	task.Status = model.TaskStatusPending
+	task.Results = make([]model.TaskResult, 0)
+	task.StartTime = time.Now()
+	task.Cancel = make(chan struct{})
+	task.Done = make(chan struct{})
 
 	// 保存到缓存
 	tm.cache.AddTask(task)
+	snapshot, _ := tm.cache.GetTaskSnapshot(task.ID)
 
-	// 异步执行任务
-	go tm.executeTask(task)
+	// 加入执行队列，队列已满时拒绝任务
+	if err := tm.queue.Push(task, true); err != nil {
+		tm.cache.RemoveTask(task.ID)
+		return nil, err
+	}
 
-	return task, nil
+	return &snapshot, nil
+}
+
+// SubmitTask 按幂等键提交任务，task.IdempotencyKey 为空时等同于 CreateTask，返回的都是任务副本。
+// 幂等键已存在且提交内容相同时返回已有任务，replayed 为 true；内容不同时返回 ErrIdempotencyConflict
+func (tm *TaskManager) SubmitTask(task *model.Task) (result *model.Task, replayed bool, err error) {
+	if task.IdempotencyKey == "" {
+		result, err = tm.CreateTask(task)
+		return result, false, err
+	}
+
+	hash, err := taskPayloadHash(task)
+	if err != nil {
+		return nil, false, fmt.Errorf("计算任务内容摘要失败: %v", err)
+	}
+	task.PayloadHash = hash
+
+	// 持有锁完成查重和创建，避免并发重试重复创建任务
+	tm.mutex.Lock()
+	defer tm.mutex.Unlock()
+
+	if taskID, exists := tm.idempotencyKeys[task.IdempotencyKey]; exists {
+		if existing, found := tm.cache.GetTaskSnapshot(taskID); found {
+			if existing.PayloadHash != hash {
+				return nil, false, fmt.Errorf("%w: %s 已用于任务 %s，且提交内容不同", ErrIdempotencyConflict, task.IdempotencyKey, taskID)
+			}
+			return &existing, true, nil
+		}
+		// 原任务已过期清理，幂等键可以重新使用
+		delete(tm.idempotencyKeys, task.IdempotencyKey)
+	}
+
+	result, err = tm.CreateTask(task)
+	if err != nil {
+		return nil, false, err
+	}
+	tm.idempotencyKeys[task.IdempotencyKey] = task.ID
+	return result, false, nil
+}
+
+// rememberIdempotencyKey 记录已加载任务的幂等键
+func (tm *TaskManager) rememberIdempotencyKey(task *model.Task) {
+	if task.IdempotencyKey == "" {
+		return
+	}
+	tm.mutex.Lock()
+	defer tm.mutex.Unlock()
+	tm.idempotencyKeys[task.IdempotencyKey] = task.ID
 }
 
 // GetTask 获取任务状态和结果
@@ -83,25 +227,153 @@ func (tm *TaskManager) GetTask(taskID string) (*model.Task, error) {
 	return task, nil
 }
 
-// CancelTask 取消任务
+// GetTaskSnapshot 获取任务副本，用于在任务执行过程中读取状态和结果
+func (tm *TaskManager) GetTaskSnapshot(taskID string) (*model.Task, error) {
+	task, exists := tm.cache.GetTaskSnapshot(taskID)
+	if !exists {
+		return nil, fmt.Errorf("任务不存在: %s", taskID)
+	}
+	return &task, nil
+}
+
+// ListTasks 获取所有任务的副本
+func (tm *TaskManager) ListTasks() []model.Task {
+	return tm.cache.ListTaskSnapshots()
+}
+
+// WaitTask 等待任务执行结束，返回任务副本，ctx 结束时提前返回
+func (tm *TaskManager) WaitTask(ctx context.Context, taskID string) (*model.Task, error) {
+	task, err := tm.GetTask(taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-task.Done:
+		return tm.GetTaskSnapshot(taskID)
+	case <-ctx.Done():
+		snapshot, _ := tm.GetTaskSnapshot(taskID)
+		return snapshot, fmt.Errorf("等待任务完成被中断: %v", ctx.Err())
+	}
+}
+
+// CancelTask 取消任务，排队中的任务直接从队列移除
 func (tm *TaskManager) CancelTask(taskID string) error {
 	if success := tm.cache.CancelTask(taskID); !success {
 		return fmt.Errorf("取消任务失败: %s，任务可能不存在或已完成", taskID)
 	}
+
+	if tm.queue.Remove(taskID) {
+		if task, exists := tm.cache.GetTask(taskID); exists {
+			close(task.Done)
+			tm.sendCallback(task.ID)
+		}
+	}
 	return nil
 }
 
-// executeTask 异步执行任务
-func (tm *TaskManager) executeTask(task *model.Task) {
-	// 获取工作池令牌
-	tm.workerPool <- struct{}{}
-	defer func() {
-		// 释放工作池令牌
-		<-tm.workerPool
+// QueuePosition 获取任务的排队位置和当前排队任务数，任务不在队列中时位置为0
+func (tm *TaskManager) QueuePosition(taskID string) (position int, length int) {
+	return tm.queue.Position(taskID), tm.queue.Len()
+}
+
+// SubscribeTaskEvents 订阅任务执行事件，调用方需在结束后调用返回的取消订阅函数
+func (tm *TaskManager) SubscribeTaskEvents(taskID string) (<-chan TaskEvent, func()) {
+	return tm.events.subscribe(taskID)
+}
+
+// worker 工作协程，按优先级从队列中取出任务执行，队列关闭后退出
+func (tm *TaskManager) worker() {
+	defer tm.workers.Done()
+	for {
+		task := tm.queue.Pop()
+		if task == nil {
+			return
+		}
+		tm.executeTask(task)
+	}
+}
+
+// Shutdown 停止接收新任务，排队中的任务直接标记为中断，执行中的任务在 ctx 结束前继续执行，
+// 超时后仍未完成的任务被中断。中断的任务在可恢复时保持等待状态，重启后继续执行，否则标记为失败，都会发送回调
+func (tm *TaskManager) Shutdown(ctx context.Context) {
+	tm.queue.Close()
+
+	queued := tm.queue.Drain()
+	for _, task := range queued {
+		tm.interruptTask(task)
+	}
+
+	finished := make(chan struct{})
+	go func() {
+		tm.workers.Wait()
+		close(finished)
 	}()
 
+	select {
+	case <-finished:
+		hlog.Infof("任务管理器已关闭，中断排队任务 %d 个", len(queued))
+		return
+	case <-ctx.Done():
+	}
+
+	hlog.Warnf("等待执行中的任务完成超时，中断剩余任务")
+	close(tm.stopping)
+
+	select {
+	case <-finished:
+	case <-time.After(5 * time.Second):
+		hlog.Errorf("部分任务未能在中断后及时结束")
+	}
+}
+
+// isStopping 是否已因关闭超时开始中断执行中的任务
+func (tm *TaskManager) isStopping() bool {
+	select {
+	case <-tm.stopping:
+		return true
+	default:
+		return false
+	}
+}
+
+// interruptTask 标记因 Agent 关闭未执行完的任务并发送回调
+func (tm *TaskManager) interruptTask(task *model.Task) {
+	tm.cache.SaveTask(task.ID, func(t *model.Task) {
+		t.Interrupted = true
+		if tm.resumable {
+			// 已完成的任务项结果已保存，重启后只执行剩余的任务项
+			t.Status = model.TaskStatusPending
+			return
+		}
+		t.Status = model.TaskStatusFailed
+		t.EndTime = time.Now()
+		t.Error = "任务因 Agent 关闭中断"
+	})
+	close(task.Done)
+	tm.sendCallback(task.ID)
+	if snapshot, exists := tm.cache.GetTaskSnapshot(task.ID); exists {
+		hlog.Warnf("任务因 Agent 关闭中断: %s, 状态 %s", task.ID, snapshot.Status)
+	}
+}
+
+// executeTask 执行任务
+func (tm *TaskManager) executeTask(task *model.Task) {
+	// 出队前已被取消，直接结束
+	select {
+	case <-task.Cancel:
+		close(task.Done)
+		tm.sendCallback(task.ID)
+		return
+	default:
+	}
+
 	// 更新任务状态为执行中
-	tm.cache.UpdateTaskStatus(task.ID, model.TaskStatusRunning)
+	tm.cache.SaveTask(task.ID, func(t *model.Task) {
+		t.Status = model.TaskStatusRunning
+		t.ExecuteTime = time.Now()
+	})
+	tm.events.publish(task.ID, TaskEvent{Type: TaskEventStatus, Status: model.TaskStatusRunning})
 
 	// 创建带超时的上下文
 	timeout := time.Duration(task.Timeout) * time.Second
@@ -113,77 +385,277 @@ func (tm *TaskManager) executeTask(task *model.Task) {
 	defer cancel()
 
 	// 创建取消监听goroutine
+	interrupted := make(chan struct{})
 	go func() {
 		select {
 		case <-task.Cancel:
 			// 任务被手动取消
 			cancel()
+		case <-tm.stopping:
+			// Agent 关闭时排空超时
+			close(interrupted)
+			cancel()
 		case <-ctx.Done():
 			// 上下文超时或已取消，不需要额外操作
 		}
 	}()
 
-	results := make([]model.TaskResult, 0, len(task.Items))
+	results := tm.runTaskItems(ctx, task)
 
-	for _, item := range task.Items {
-		// 检查上下文是否已取消
-		if ctx.Err() != nil {
-			break
+	// 更新任务结果
+	tm.cache.UpdateTaskResult(task.ID, results)
+
+	// 因 Agent 关闭被中断时按任务项是否都有结果决定任务状态，
+	// 关闭时被打断的任务项不保存结果，所有任务项都有结果说明任务已经执行完
+	stopped := false
+	select {
+	case <-interrupted:
+		if len(results) < len(task.Items) {
+			tm.interruptTask(task)
+			return
 		}
+		stopped = true
+	default:
+	}
 
-		// 执行单个任务项
-		result, err := tm.executeTaskItem(ctx, item)
-		if err != nil {
-			hlog.Errorf("执行任务项失败: %v", err)
-			results = append(results, model.TaskResult{
-				ItemID:   item.ID,
-				Status:   model.ResultStatusFailed,
-				Message:  fmt.Sprintf("执行失败: %v", err),
-				Details:  fmt.Sprintf("任务项执行错误: %v", err),
-				Duration: 0,
-			})
-			continue
+	// 根据上下文判断任务状态，状态和错误信息一起修改并持久化
+	tm.cache.SaveTask(task.ID, func(t *model.Task) {
+		switch {
+		case t.Status == model.TaskStatusCanceled:
+			// 被取消的任务状态已更新为Canceled
+			return
+		case stopped:
+			// 任务项都已执行完，上下文只是因 Agent 关闭被取消
+			t.Status = model.TaskStatusCompleted
+		case ctx.Err() == context.Canceled:
+			t.Status = model.TaskStatusCanceled
+		case ctx.Err() == context.DeadlineExceeded:
+			// 任务超时
+			t.Status = model.TaskStatusFailed
+			t.Error = "任务执行超时"
+		default:
+			// 任务正常完成
+			t.Status = model.TaskStatusCompleted
 		}
+		t.EndTime = time.Now()
+	})
+
+	// 通知等待方任务已结束
+	close(task.Done)
 
-		results = append(results, result)
+	// 发送回调
+	tm.sendCallback(task.ID)
+}
+
+// runTaskItems 按依赖关系并发执行任务项，返回按任务项顺序排列的结果
+func (tm *TaskManager) runTaskItems(ctx context.Context, task *model.Task) []model.TaskResult {
+	graph, err := buildTaskGraph(task.Items)
+	if err != nil {
+		// 提交时已校验，这里只做防御
+		hlog.Errorf("构建任务项依赖图失败: %v", err)
+		return nil
 	}
 
-	// 更新任务结果
-	tm.cache.UpdateTaskResult(task.ID, results)
+	parallelism := task.Parallelism
+	if parallelism <= 0 {
+		parallelism = tm.itemParallelism
+	}
 
-	// 根据上下文判断任务状态
-	if ctx.Err() == context.Canceled {
-		// 如果是被取消，状态已更新为Canceled
-		if task.Status != model.TaskStatusCanceled {
-			tm.cache.UpdateTaskStatus(task.ID, model.TaskStatusCanceled)
+	semaphore := make(chan struct{}, parallelism)
+	finished := make([]chan struct{}, len(task.Items))
+	for i := range finished {
+		finished[i] = make(chan struct{})
+	}
+	itemResults := make([]*model.TaskResult, len(task.Items))
+
+	// 恢复执行的任务保留之前已完成的任务项结果
+	for _, result := range task.Results {
+		if i, exists := graph.index[result.ItemID]; exists {
+			result := result
+			itemResults[i] = &result
 		}
-	} else if ctx.Err() == context.DeadlineExceeded {
-		// 任务超时
-		tm.cache.UpdateTaskStatus(task.ID, model.TaskStatusFailed)
-		task.Error = "任务执行超时"
-	} else {
-		// 任务正常完成
-		tm.cache.UpdateTaskStatus(task.ID, model.TaskStatusCompleted)
 	}
 
-	// 发送回调
-	tm.sendCallback(task)
+	// 记录任务项结果，与同一巡检项的上一次结果比较，保存执行进度并通知订阅者。
+	// 任务被取消或中断时的结果不代表巡检项的真实状态，不计入历史
+	record := func(i int, result model.TaskResult) {
+		if ctx.Err() == nil && result.Status != model.ResultStatusSkipped {
+			GetResultHistory().Annotate(task.ID, task.Items[i], &result)
+		}
+		itemResults[i] = &result
+		tm.cache.AddTaskResult(task.ID, result)
+		tm.events.publish(task.ID, TaskEvent{Type: TaskEventResult, Result: &result})
+	}
+
+	var wg sync.WaitGroup
+	for i, item := range task.Items {
+		wg.Add(1)
+		go func(i int, item model.TaskItem) {
+			defer wg.Done()
+			defer close(finished[i])
+
+			if itemResults[i] != nil {
+				return
+			}
+
+			// 等待依赖的任务项执行完成
+			for _, dep := range graph.dependsOn[i] {
+				select {
+				case <-finished[dep]:
+				case <-ctx.Done():
+					return
+				}
+			}
+			// 依赖因任务取消而结束时不再计算执行条件和参数引用，避免记录虚假的失败结果
+			if ctx.Err() != nil {
+				return
+			}
+
+			// 执行条件不满足或无法计算时不执行任务项，直接记录结果
+			if condition := graph.conditions[i]; condition != nil {
+				if result, skip := checkItemCondition(item, condition, graph, itemResults); skip {
+					record(i, result)
+					return
+				}
+			}
+
+			// 替换参数中对之前任务项结果的引用，无法替换时任务项失败
+			item, err := tm.resolveItemParams(item, graph, itemResults)
+			if err != nil {
+				record(i, model.TaskResult{
+					ItemID:  item.ID,
+					Status:  model.ResultStatusFailed,
+					Message: fmt.Sprintf("参数引用解析失败: %v", err),
+				})
+				return
+			}
+
+			// 获取并发令牌
+			select {
+			case semaphore <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			defer func() { <-semaphore }()
+
+			// 检查上下文是否已取消
+			if ctx.Err() != nil {
+				return
+			}
+
+			result := tm.runTaskItem(ctx, item)
+
+			// Agent 关闭时被打断的任务项不保存结果，恢复执行时重新执行
+			if ctx.Err() != nil && tm.isStopping() {
+				return
+			}
+			record(i, result)
+		}(i, item)
+	}
+	wg.Wait()
+
+	results := make([]model.TaskResult, 0, len(task.Items))
+	for _, result := range itemResults {
+		if result != nil {
+			results = append(results, *result)
+		}
+	}
+	return results
 }
 
-// executeTaskItem 执行单个任务项
-func (tm *TaskManager) executeTaskItem(ctx context.Context, item model.TaskItem) (model.TaskResult, error) {
+// runTaskItem 执行单个任务项，按任务项的重试配置重试，执行错误转换为失败结果
+func (tm *TaskManager) runTaskItem(ctx context.Context, item model.TaskItem) model.TaskResult {
 	startTime := time.Now()
 
+	var (
+		result   model.TaskResult
+		attempts []model.TaskAttempt
+		waitTime int64
+	)
+	for attempt := 1; ; attempt++ {
+		attemptStart := time.Now()
+		attemptResult, err := tm.executeTaskItem(ctx, item)
+		waitTime += attemptResult.WaitTime
+		if err != nil {
+			hlog.Errorf("执行任务项失败: %v", err)
+			attemptResult = model.TaskResult{
+				ItemID:  item.ID,
+				Status:  model.ResultStatusFailed,
+				Message: fmt.Sprintf("执行失败: %v", err),
+				Details: fmt.Sprintf("任务项执行错误: %v", err),
+				Final:   attemptResult.Final,
+			}
+		}
+		result = attemptResult
+
+		record := model.TaskAttempt{
+			Attempt:   attempt,
+			Status:    attemptResult.Status,
+			Message:   attemptResult.Message,
+			StartTime: attemptStart,
+			Duration:  time.Since(attemptStart).Milliseconds(),
+		}
+		if err != nil {
+			record.Error = err.Error()
+		}
+		attempts = append(attempts, record)
+
+		if attempt > item.Retries || !shouldRetry(item, attemptResult, err) {
+			break
+		}
+
+		// 等待重试间隔，任务取消或超时时停止重试
+		hlog.Warnf("任务项 %d 第 %d 次执行结果为 %s，%d 秒后重试", item.ID, attempt, attemptResult.Status, item.RetryBackoff)
+		if item.RetryBackoff > 0 {
+			select {
+			case <-time.After(time.Duration(item.RetryBackoff) * time.Second):
+			case <-ctx.Done():
+			}
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	// 配置了重试时记录每次执行情况，耗时为所有执行的总耗时
+	if item.Retries > 0 {
+		result.Attempts = attempts
+	}
+	result.Duration = time.Since(startTime).Milliseconds()
+	result.WaitTime = waitTime
+	return result
+}
+
+// executeTaskItem 执行一次任务项，任务项设置了超时时间时单次执行不超过该时间。
+// 执行前等待执行器和目标的限流令牌，等待时间不计入单次执行超时，记录在结果的 WaitTime 中
+func (tm *TaskManager) executeTaskItem(ctx context.Context, item model.TaskItem) (model.TaskResult, error) {
 	// 获取执行器
-	executor, err := tm.executorFactory.Create(item.Type)
+	exec, err := tm.executorFactory.Create(item.Type)
 	if err != nil {
 		return model.TaskResult{}, fmt.Errorf("创建执行器失败: %v", err)
 	}
 
+	// 等待限流令牌
+	release, wait, err := tm.limiter.Acquire(ctx, item.Type, executor.TargetOf(exec, item))
+	waitResult := model.TaskResult{WaitTime: wait.Milliseconds()}
+	if err != nil {
+		return waitResult, fmt.Errorf("等待执行器限流时中止: %v", err)
+	}
+	defer release()
+
+	startTime := time.Now()
+
+	// 设置单次执行超时
+	if item.TimeoutSeconds > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(item.TimeoutSeconds)*time.Second)
+		defer cancel()
+	}
+
 	// 执行任务
-	executionResult, err := executor.Execute(ctx, item)
+	executionResult, err := exec.Execute(ctx, item)
 	if err != nil {
-		return model.TaskResult{}, err
+		return waitResult, err
 	}
 
 	// 计算执行时间
@@ -197,61 +669,31 @@ func (tm *TaskManager) executeTaskItem(ctx context.Context, item model.TaskItem)
 		Message:  executionResult.Message,
 		Details:  executionResult.Details,
 		Duration: duration,
+		Labels:   executionResult.Labels,
+		WaitTime: waitResult.WaitTime,
 	}
 
 	return result, nil
 }
 
-// sendCallback 发送回调通知Server任务完成
-func (tm *TaskManager) sendCallback(task *model.Task) {
-	if tm.callbackURL == "" {
-		hlog.Warn("回调URL未配置，跳过回调")
-		return
-	}
-
-	// 准备回调数据
-	callback := model.TaskCallback{
-		TaskID:    task.ID,
-		Status:    task.Status,
-		Results:   task.Results,
-		StartTime: task.StartTime,
-		EndTime:   task.EndTime,
-		Error:     task.Error,
-	}
-
-	// 序列化数据
-	data, err := json.Marshal(callback)
-	if err != nil {
-		hlog.Errorf("序列化回调数据失败: %v", err)
-		return
-	}
-
-	// 发送HTTP请求
-	req, err := http.NewRequest(http.MethodPost, tm.callbackURL, bytes.NewBuffer(data))
-	if err != nil {
-		hlog.Errorf("创建回调请求失败: %v", err)
-		return
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-
-	// 设置API Key (如果配置了)
-	if apiKey := conf.GetConf().Server.APIKey; apiKey != "" {
-		req.Header.Set("X-API-Key", apiKey)
-	}
-
-	client := &http.Client{Timeout: 10 * time.Second}
-	resp, err := client.Do(req)
-	if err != nil {
-		hlog.Errorf("发送回调请求失败: %v", err)
-		return
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		hlog.Errorf("回调请求返回非200状态码: %d", resp.StatusCode)
+// sendCallback 将任务回调加入发件箱，由发件箱负责投递和重试。
+// 回调内容取自任务副本，避免与恢复、中断等修改同一任务的流程竞争
+func (tm *TaskManager) sendCallback(taskID string) {
+	task, exists := tm.cache.GetTaskSnapshot(taskID)
+	if !exists {
+		hlog.Warnf("任务不存在，跳过回调: %s", taskID)
 		return
 	}
-
-	hlog.Infof("成功发送任务回调: %s", task.ID)
+	GetCallbackOutbox().Enqueue(model.TaskCallback{
+		TaskID:      task.ID,
+		Status:      task.Status,
+		Results:     task.Results,
+		StartTime:   task.StartTime,
+		EndTime:     task.EndTime,
+		Error:       task.Error,
+		Interrupted: task.Interrupted,
+		ScheduleID:  task.ScheduleID,
+		Summary:     summarizeTaskHealth(&task),
+		Changes:     collectResultChanges(&task),
+	})
 }
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+)
+
+func TestCreateTaskReturnsSnapshot(t *testing.T) {
+	tm := GetTaskManager()
+	id := testTaskID(t)
+	task := &model.Task{
+		ID:      id,
+		Timeout: 5,
+		Items:   []model.TaskItem{fakeItem(id, 1, "block", map[string]interface{}{"block": true})},
+	}
+	created, err := tm.CreateTask(task)
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	if created == task {
+		t.Fatal("CreateTask returned the cached task instead of a copy")
+	}
+	// 工作协程此时可能正在修改缓存中的任务，读取副本不应产生数据竞争
+	resp := buildTaskResponse(created)
+	if resp.TaskId != id {
+		t.Fatalf("TaskId = %q, want %q", resp.TaskId, id)
+	}
+
+	if _, err := tm.CreateTask(&model.Task{ID: id, Items: task.Items}); err == nil {
+		t.Fatal("CreateTask with a duplicate ID succeeded")
+	}
+
+	if err := tm.CancelTask(id); err != nil {
+		t.Fatalf("CancelTask: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	finished, err := tm.WaitTask(ctx, id)
+	if err != nil {
+		t.Fatalf("WaitTask: %v", err)
+	}
+	if finished.Status != model.TaskStatusCanceled {
+		t.Fatalf("Status = %s, want %s", finished.Status, model.TaskStatusCanceled)
+	}
+}
+
+// waitForCalls 等待 fakeExecutor 执行任务中指定名称的任务项至少 n 次
+func waitForCalls(t *testing.T, taskID, name string, n int) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for fakeCalls.count(taskID, name) < n {
+		if time.Now().After(deadline) {
+			t.Fatalf("%s executed %d times, want %d", name, fakeCalls.count(taskID, name), n)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestTaskTimeoutSetsError(t *testing.T) {
+	id := testTaskID(t)
+	finished := runTestTask(t, &model.Task{
+		ID:      id,
+		Timeout: 1,
+		Items:   []model.TaskItem{fakeItem(id, 1, "block", map[string]interface{}{"block": true})},
+	})
+	if finished.Status != model.TaskStatusFailed || finished.Error != "任务执行超时" {
+		t.Fatalf("Status = %s, Error = %q, want failed with timeout error", finished.Status, finished.Error)
+	}
+	if finished.EndTime.IsZero() {
+		t.Fatal("EndTime not set")
+	}
+}
+
+func TestInterruptedTaskWithAllResultsCompletes(t *testing.T) {
+	// 独立的任务管理器，关闭它不影响其他测试
+	tm := &TaskManager{
+		cache:           model.NewTaskCache(nil, 0),
+		executorFactory: GetTaskManager().executorFactory,
+		queue:           newTaskQueue(0),
+		itemParallelism: 5,
+		events:          newTaskEventHub(),
+		idempotencyKeys: make(map[string]string),
+		stopping:        make(chan struct{}),
+		limiter:         newExecutorLimiter(nil),
+	}
+	close(tm.stopping)
+
+	id := testTaskID(t)
+	finished := &model.Task{
+		ID:      id,
+		Status:  model.TaskStatusPending,
+		Timeout: 5,
+		Items:   []model.TaskItem{fakeItem(id, 1, "done", nil), fakeItem(id, 2, "also-done", nil)},
+		Results: []model.TaskResult{
+			{ItemID: 1, Status: model.ResultStatusNormal},
+			{ItemID: 2, Status: model.ResultStatusWarning},
+		},
+		Cancel: make(chan struct{}),
+		Done:   make(chan struct{}),
+	}
+	tm.cache.AddTask(finished)
+	tm.executeTask(finished)
+
+	got, _ := tm.GetTaskSnapshot(id)
+	if got.Status != model.TaskStatusCompleted || got.Interrupted {
+		t.Fatalf("Status = %s, Interrupted = %v, want completed", got.Status, got.Interrupted)
+	}
+	if calls := fakeCalls.count(id, "done") + fakeCalls.count(id, "also-done"); calls != 0 {
+		t.Fatalf("finished items executed %d times", calls)
+	}
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+)
+
+// paramRefPattern 任务项参数中对之前任务项结果的引用，如 {{items.1.labels.instance}}
+var paramRefPattern = regexp.MustCompile(`\{\{\s*([^{}]*?)\s*\}\}`)
+
+// parseParamRefs 解析任务项参数中引用的任务项结果，返回引用的任务项ID和包含引用的参数名
+func parseParamRefs(item model.TaskItem) (refs []uint, params map[string]bool, err error) {
+	seen := make(map[uint]bool)
+	for name, value := range item.Params {
+		s, ok := value.(string)
+		if !ok {
+			continue
+		}
+		for _, match := range paramRefPattern.FindAllStringSubmatch(s, -1) {
+			ref, err := parseResultRef(match[1])
+			if err != nil {
+				return nil, nil, fmt.Errorf("参数 %s: %v", name, err)
+			}
+			if params == nil {
+				params = make(map[string]bool)
+			}
+			params[name] = true
+			if !seen[ref.itemID] {
+				seen[ref.itemID] = true
+				refs = append(refs, ref.itemID)
+			}
+		}
+	}
+	sort.Slice(refs, func(i, j int) bool { return refs[i] < refs[j] })
+	return refs, params, nil
+}
+
+// deferredParams 返回引用了其他任务项结果的参数名，这些参数的取值在执行时才能确定
+func deferredParams(item model.TaskItem) map[string]bool {
+	_, params, _ := parseParamRefs(item)
+	return params
+}
+
+// renderItemParams 使用任务项结果替换参数中的引用，返回替换后的任务项副本，
+// 引用的任务项没有结果或引用的标签、JSON 字段不存在时返回错误
+func renderItemParams(item model.TaskItem, results map[uint]model.TaskResult) (model.TaskItem, error) {
+	rendered := item
+	rendered.Params = make(map[string]interface{}, len(item.Params))
+
+	var errs []string
+	for name, value := range item.Params {
+		s, ok := value.(string)
+		if !ok || !strings.Contains(s, "{{") {
+			rendered.Params[name] = value
+			continue
+		}
+
+		rendered.Params[name] = paramRefPattern.ReplaceAllStringFunc(s, func(match string) string {
+			ref, err := parseResultRef(paramRefPattern.FindStringSubmatch(match)[1])
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("参数 %s: %v", name, err))
+				return match
+			}
+			resolved, err := ref.resolve(results)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("参数 %s 引用 %s 失败: %v", name, ref, err))
+				return match
+			}
+			return resolved
+		})
+	}
+
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return item, fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return rendered, nil
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+)
+
+func TestRenderItemParams(t *testing.T) {
+	results := map[uint]model.TaskResult{
+		1: {ItemID: 1, Status: model.ResultStatusWarning, Value: "92.5", Labels: map[string]string{
+			"instance":               "10.0.0.1:9100",
+			"app.kubernetes.io/name": "nginx",
+		}},
+		2: {ItemID: 2, Status: model.ResultStatusNormal, Value: `{"disks":[{"name":"sda","mount":"/data"}],"ready-replicas":2}`},
+		4: {ItemID: 4, Status: model.ResultStatusNormal, Value: "node-1"},
+	}
+
+	tests := []struct {
+		name    string
+		params  map[string]interface{}
+		want    map[string]interface{}
+		wantErr string
+	}{
+		{
+			name:   "value",
+			params: map[string]interface{}{"threshold": "{{items.1.value}}"},
+			want:   map[string]interface{}{"threshold": "92.5"},
+		},
+		{
+			name:   "label",
+			params: map[string]interface{}{"host": "{{ items.1.labels.instance }}"},
+			want:   map[string]interface{}{"host": "10.0.0.1:9100"},
+		},
+		{
+			name:   "label with slash",
+			params: map[string]interface{}{"app": "{{items.1.labels.app.kubernetes.io/name}}"},
+			want:   map[string]interface{}{"app": "nginx"},
+		},
+		{
+			name:   "json path",
+			params: map[string]interface{}{"command": "df -h {{items.2.json.disks.0.mount}}"},
+			want:   map[string]interface{}{"command": "df -h /data"},
+		},
+		{
+			name:   "json number with dash",
+			params: map[string]interface{}{"replicas": "{{items.2.json.ready-replicas}}"},
+			want:   map[string]interface{}{"replicas": "2"},
+		},
+		{
+			name:   "several refs in one param",
+			params: map[string]interface{}{"command": "echo {{items.1.labels.instance}} {{items.2.json.disks.0.name}}"},
+			want:   map[string]interface{}{"command": "echo 10.0.0.1:9100 sda"},
+		},
+		{
+			name:   "params without refs are kept",
+			params: map[string]interface{}{"port": float64(22), "user": "root"},
+			want:   map[string]interface{}{"port": float64(22), "user": "root"},
+		},
+		{
+			name:    "missing label",
+			params:  map[string]interface{}{"host": "{{items.1.labels.host}}"},
+			wantErr: "参数 host 引用 items.1.labels.host 失败",
+		},
+		{
+			name:    "missing json field",
+			params:  map[string]interface{}{"disk": "{{items.2.json.disks.3.name}}"},
+			wantErr: "结果中不存在该字段",
+		},
+		{
+			name:    "value is not json",
+			params:  map[string]interface{}{"disk": "{{items.4.json.disks}}"},
+			wantErr: "不是有效的 JSON",
+		},
+		{
+			name:    "missing result",
+			params:  map[string]interface{}{"host": "{{items.3.value}}"},
+			wantErr: "任务项 3 没有执行结果",
+		},
+		{
+			name:    "invalid ref",
+			params:  map[string]interface{}{"host": "{{items.1.output}}"},
+			wantErr: "无效的结果引用",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := model.TaskItem{ID: 9, Params: tt.params}
+			rendered, err := renderItemParams(item, results)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("renderItemParams() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("renderItemParams() error = %v", err)
+			}
+			for name, want := range tt.want {
+				if got := rendered.Params[name]; got != want {
+					t.Errorf("params[%s] = %v, want %v", name, got, want)
+				}
+			}
+			for name, value := range tt.params {
+				if item.Params[name] != value {
+					t.Errorf("original params[%s] changed to %v", name, item.Params[name])
+				}
+			}
+		})
+	}
+}
+
+func TestParamRefsSubstitutedAtRun(t *testing.T) {
+	taskID := testTaskID(t)
+	task := runTestTask(t, &model.Task{ID: taskID, Items: []model.TaskItem{
+		fakeItem(taskID, 1, "query", map[string]interface{}{
+			"status": "warning",
+			"value":  `{"disks":[{"name":"sda"}]}`,
+			"labels": map[string]string{"instance": "node-1"},
+		}),
+		fakeItem(taskID, 2, "inspect", map[string]interface{}{
+			"value": "{{items.1.labels.instance}}:{{items.1.json.disks.0.name}}",
+		}),
+	}})
+
+	result := resultOf(task, 2)
+	if result == nil || result.Status != model.ResultStatusNormal || result.Value != "node-1:sda" {
+		t.Fatalf("item 2 result = %+v, want normal with value node-1:sda", result)
+	}
+}
+
+func TestParamRefToSkippedOrFailedDependency(t *testing.T) {
+	tests := []struct {
+		name       string
+		dependency map[string]interface{}
+		when       string
+		wantStatus model.ResultStatus
+	}{
+		{name: "skipped", dependency: map[string]interface{}{}, when: `items.3.status == "critical"`, wantStatus: model.ResultStatusSkipped},
+		{name: "failed", dependency: map[string]interface{}{"error": "connection refused"}, wantStatus: model.ResultStatusFailed},
+		{name: "failed status", dependency: map[string]interface{}{"status": "failed", "value": "42"}, wantStatus: model.ResultStatusFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskID := testTaskID(t)
+			dependency := fakeItem(taskID, 1, "dependency", tt.dependency)
+			dependency.When = tt.when
+			task := runTestTask(t, &model.Task{ID: taskID, Items: []model.TaskItem{
+				dependency,
+				fakeItem(taskID, 2, "consumer", map[string]interface{}{"value": "{{items.1.value}}"}),
+				fakeItem(taskID, 3, "gate", nil),
+			}})
+
+			if result := resultOf(task, 1); result == nil || result.Status != tt.wantStatus {
+				t.Fatalf("item 1 result = %+v, want %s", result, tt.wantStatus)
+			}
+			result := resultOf(task, 2)
+			if result == nil || result.Status != model.ResultStatusFailed {
+				t.Fatalf("item 2 result = %+v, want failed", result)
+			}
+			if want := "引用的任务项 1 结果为 " + string(tt.wantStatus); !strings.Contains(result.Message, want) {
+				t.Errorf("item 2 message = %q, want %q", result.Message, want)
+			}
+			if n := fakeCalls.count(taskID, "consumer"); n != 0 {
+				t.Errorf("consumer executed %d times, want 0", n)
+			}
+		})
+	}
+}
+
+func TestParamRefCycleRejected(t *testing.T) {
+	taskID := testTaskID(t)
+	task := &model.Task{ID: taskID, Items: []model.TaskItem{
+		fakeItem(taskID, 1, "a", map[string]interface{}{"value": "{{items.3.value}}"}),
+		fakeItem(taskID, 2, "b", map[string]interface{}{"value": "{{items.1.labels.host}}"}),
+		fakeItem(taskID, 3, "c", map[string]interface{}{"value": "x-{{items.2.json.name}}"}),
+	}}
+
+	_, err := GetTaskManager().CreateTask(task)
+	if err == nil || !strings.Contains(err.Error(), "循环依赖") {
+		t.Fatalf("CreateTask() error = %v, want cycle error", err)
+	}
+	if _, err := GetTaskManager().GetTask(taskID); err == nil {
+		t.Errorf("task %s was created", taskID)
+	}
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+)
+
+// ItemParamError 单个任务项的参数校验错误
+type ItemParamError struct {
+	ItemID uint     `json:"item_id"`
+	Name   string   `json:"name,omitempty"`
+	Type   string   `json:"type"`
+	Errors []string `json:"errors"`
+}
+
+// TaskParamError 任务项参数校验失败，按任务项列出所有错误
+type TaskParamError struct {
+	Items []ItemParamError `json:"item_errors"`
+}
+
+func (e *TaskParamError) Error() string {
+	messages := make([]string, 0, len(e.Items))
+	for _, item := range e.Items {
+		messages = append(messages, fmt.Sprintf("任务项 %d: %s", item.ItemID, strings.Join(item.Errors, "; ")))
+	}
+	return "任务项参数校验失败: " + strings.Join(messages, "; ")
+}
+
+// validateTaskItemParams 按执行器参数说明校验任务项参数并补齐默认值，执行器不存在也视为参数错误
+func (tm *TaskManager) validateTaskItemParams(items []model.TaskItem) error {
+	var itemErrors []ItemParamError
+	for i := range items {
+		item := &items[i]
+		if item.Params == nil {
+			item.Params = make(map[string]interface{})
+		}
+
+		var messages []string
+		executor, err := tm.executorFactory.Create(item.Type)
+		if err != nil {
+			messages = append(messages, fmt.Sprintf("不支持的执行器类型: %s", item.Type))
+		} else {
+			// 引用其他任务项结果的参数在执行时替换后再校验
+			for _, err := range executor.Schema().ValidateDeferred(item.Params, deferredParams(*item)) {
+				messages = append(messages, err.Error())
+			}
+		}
+
+		if len(messages) > 0 {
+			itemErrors = append(itemErrors, ItemParamError{
+				ItemID: item.ID,
+				Name:   item.Name,
+				Type:   item.Type,
+				Errors: messages,
+			})
+		}
+	}
+
+	if len(itemErrors) > 0 {
+		return &TaskParamError{Items: itemErrors}
+	}
+	return nil
+}
+
+// resolveItemParams 使用依赖任务项的结果替换参数中的引用，并按执行器参数说明校验替换后的参数
+func (tm *TaskManager) resolveItemParams(item model.TaskItem, graph *taskGraph, itemResults []*model.TaskResult) (model.TaskItem, error) {
+	refs, params, err := parseParamRefs(item)
+	if err != nil || len(refs) == 0 {
+		return item, err
+	}
+
+	// 跳过或失败的任务项没有可用的结果值，引用它们时任务项失败，避免使用空值执行
+	results := make(map[uint]model.TaskResult, len(refs))
+	for _, refID := range refs {
+		ref := itemResults[graph.index[refID]]
+		if ref == nil {
+			continue
+		}
+		if ref.Status == model.ResultStatusSkipped || ref.Status == model.ResultStatusFailed {
+			return item, fmt.Errorf("引用的任务项 %d 结果为 %s", refID, ref.Status)
+		}
+		results[refID] = *ref
+	}
+	rendered, err := renderItemParams(item, results)
+	if err != nil {
+		return item, err
+	}
+
+	executor, err := tm.executorFactory.Create(item.Type)
+	if err != nil {
+		return item, err
+	}
+	var messages []string
+	for _, err := range executor.Schema().Validate(rendered.Params) {
+		messages = append(messages, err.Error())
+	}
+	if len(messages) > 0 {
+		names := make([]string, 0, len(params))
+		for name := range params {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return item, fmt.Errorf("替换参数 %s 后校验失败: %s", strings.Join(names, ", "), strings.Join(messages, "; "))
+	}
+	return rendered, nil
+}
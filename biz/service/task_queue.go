@@ -0,0 +1,166 @@
+package service
+
+import (
+	"container/heap"
+	"fmt"
+	"sync"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+)
+
+// queueEntry 任务队列中的任务
+type queueEntry struct {
+	task     *model.Task
+	priority int
+	seq      uint64 // 入队序号，同优先级按入队顺序执行
+	index    int    // 在堆中的下标
+}
+
+// entryHeap 按优先级从高到低、同优先级按入队顺序排列的堆
+type entryHeap []*queueEntry
+
+func (h entryHeap) Len() int { return len(h) }
+func (h entryHeap) Less(i, j int) bool {
+	if h[i].priority != h[j].priority {
+		return h[i].priority > h[j].priority
+	}
+	return h[i].seq < h[j].seq
+}
+func (h entryHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+func (h *entryHeap) Push(x interface{}) {
+	entry := x.(*queueEntry)
+	entry.index = len(*h)
+	*h = append(*h, entry)
+}
+func (h *entryHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	entry := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return entry
+}
+
+// taskQueue 优先级任务队列，优先级高的任务先执行，同优先级先进先出
+type taskQueue struct {
+	entries  entryHeap
+	byID     map[string]*queueEntry
+	maxDepth int
+	seq      uint64
+	closed   bool // 关闭后不再接收和取出任务
+	mutex    sync.Mutex
+	cond     *sync.Cond
+}
+
+// newTaskQueue 创建任务队列，maxDepth 为最大排队任务数
+func newTaskQueue(maxDepth int) *taskQueue {
+	q := &taskQueue{
+		byID:     make(map[string]*queueEntry),
+		maxDepth: maxDepth,
+	}
+	q.cond = sync.NewCond(&q.mutex)
+	return q
+}
+
+// Push 任务入队，enforceLimit 为 true 时队列已满返回错误
+func (q *taskQueue) Push(task *model.Task, enforceLimit bool) error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if q.closed {
+		return fmt.Errorf("Agent 正在关闭，不再接受新任务")
+	}
+	if enforceLimit && q.maxDepth > 0 && len(q.entries) >= q.maxDepth {
+		return fmt.Errorf("任务队列已满，当前排队 %d 个任务", len(q.entries))
+	}
+
+	q.seq++
+	entry := &queueEntry{task: task, priority: task.Priority, seq: q.seq}
+	heap.Push(&q.entries, entry)
+	q.byID[task.ID] = entry
+	q.cond.Signal()
+	return nil
+}
+
+// Pop 取出优先级最高的任务，队列为空时阻塞等待，队列关闭后返回 nil
+func (q *taskQueue) Pop() *model.Task {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	for len(q.entries) == 0 && !q.closed {
+		q.cond.Wait()
+	}
+	if q.closed {
+		return nil
+	}
+
+	entry := heap.Pop(&q.entries).(*queueEntry)
+	delete(q.byID, entry.task.ID)
+	return entry.task
+}
+
+// Remove 从队列中移除任务，任务不在队列中时返回 false
+func (q *taskQueue) Remove(taskID string) bool {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	entry, exists := q.byID[taskID]
+	if !exists {
+		return false
+	}
+	heap.Remove(&q.entries, entry.index)
+	delete(q.byID, taskID)
+	return true
+}
+
+// Position 获取任务的排队位置，从1开始，任务不在队列中时返回0
+func (q *taskQueue) Position(taskID string) int {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	entry, exists := q.byID[taskID]
+	if !exists {
+		return 0
+	}
+
+	position := 1
+	for _, other := range q.entries {
+		if other != entry && (other.priority > entry.priority || (other.priority == entry.priority && other.seq < entry.seq)) {
+			position++
+		}
+	}
+	return position
+}
+
+// Len 当前排队任务数
+func (q *taskQueue) Len() int {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return len(q.entries)
+}
+
+// Close 关闭队列，唤醒所有等待中的工作协程
+func (q *taskQueue) Close() {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.closed = true
+	q.cond.Broadcast()
+}
+
+// Drain 取出所有排队中的任务，按执行顺序返回
+func (q *taskQueue) Drain() []*model.Task {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	tasks := make([]*model.Task, 0, len(q.entries))
+	for len(q.entries) > 0 {
+		entry := heap.Pop(&q.entries).(*queueEntry)
+		delete(q.byID, entry.task.ID)
+		tasks = append(tasks, entry.task)
+	}
+	return tasks
+}
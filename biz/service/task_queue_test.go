@@ -0,0 +1,82 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+)
+
+func TestTaskQueueOrder(t *testing.T) {
+	q := newTaskQueue(0)
+	for _, task := range []*model.Task{
+		{ID: "low-1", Priority: 0},
+		{ID: "high-1", Priority: 5},
+		{ID: "low-2", Priority: 0},
+		{ID: "mid", Priority: 1},
+		{ID: "high-2", Priority: 5},
+		{ID: "removed", Priority: 9},
+	} {
+		if err := q.Push(task, true); err != nil {
+			t.Fatalf("Push(%s): %v", task.ID, err)
+		}
+	}
+
+	if !q.Remove("removed") || q.Remove("removed") {
+		t.Fatal("Remove should succeed exactly once")
+	}
+	if got := q.Position("low-2"); got != 5 {
+		t.Fatalf("Position(low-2) = %d, want 5", got)
+	}
+	if got := q.Position("high-2"); got != 2 {
+		t.Fatalf("Position(high-2) = %d, want 2", got)
+	}
+
+	want := []string{"high-1", "high-2", "mid", "low-1", "low-2"}
+	for _, id := range want {
+		if got := q.Pop(); got.ID != id {
+			t.Fatalf("Pop = %s, want %s", got.ID, id)
+		}
+	}
+	if q.Len() != 0 {
+		t.Fatalf("Len = %d after popping everything", q.Len())
+	}
+}
+
+func TestTaskQueueMaxDepth(t *testing.T) {
+	q := newTaskQueue(2)
+	for _, id := range []string{"a", "b"} {
+		if err := q.Push(&model.Task{ID: id}, true); err != nil {
+			t.Fatalf("Push(%s): %v", id, err)
+		}
+	}
+	if err := q.Push(&model.Task{ID: "c"}, true); err == nil {
+		t.Fatal("Push over max depth succeeded")
+	}
+	// 恢复执行的任务不受排队数限制
+	if err := q.Push(&model.Task{ID: "resumed"}, false); err != nil {
+		t.Fatalf("Push without limit: %v", err)
+	}
+	if q.Len() != 3 {
+		t.Fatalf("Len = %d, want 3", q.Len())
+	}
+}
+
+func TestTaskQueueClose(t *testing.T) {
+	q := newTaskQueue(0)
+	popped := make(chan *model.Task)
+	go func() { popped <- q.Pop() }()
+
+	q.Close()
+	select {
+	case task := <-popped:
+		if task != nil {
+			t.Fatalf("Pop after close = %s, want nil", task.ID)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Pop still blocked after Close")
+	}
+	if err := q.Push(&model.Task{ID: "late"}, false); err == nil {
+		t.Fatal("Push after close succeeded")
+	}
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"fmt"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+)
+
+// maxItemRetries 单个任务项允许的最大重试次数
+const maxItemRetries = 10
+
+// validateRetryPolicy 校验任务项的超时和重试配置
+func validateRetryPolicy(item model.TaskItem) error {
+	if item.TimeoutSeconds < 0 {
+		return fmt.Errorf("任务项 %d 的超时时间不能为负数", item.ID)
+	}
+	if item.Retries < 0 || item.Retries > maxItemRetries {
+		return fmt.Errorf("任务项 %d 的重试次数必须在 0 到 %d 之间", item.ID, maxItemRetries)
+	}
+	if item.RetryBackoff < 0 {
+		return fmt.Errorf("任务项 %d 的重试间隔不能为负数", item.ID)
+	}
+	for _, condition := range item.RetryOn {
+		switch condition {
+		case model.RetryOnError, model.RetryOnFailed, model.RetryOnCritical, model.RetryOnWarning:
+		default:
+			return fmt.Errorf("任务项 %d 的重试条件不支持: %s", item.ID, condition)
+		}
+	}
+	return nil
+}
+
+// shouldRetry 根据任务项的重试条件判断本次执行结果是否需要重试。
+// 默认只重试执行错误和失败，检查发现的严重问题需要显式指定 critical 才重试
+func shouldRetry(item model.TaskItem, result model.TaskResult, err error) bool {
+	if result.Final {
+		return false
+	}
+
+	conditions := item.RetryOn
+	if len(conditions) == 0 {
+		conditions = []model.RetryCondition{model.RetryOnError, model.RetryOnFailed}
+	}
+
+	for _, condition := range conditions {
+		switch condition {
+		case model.RetryOnError:
+			if err != nil {
+				return true
+			}
+		case model.RetryOnFailed:
+			if err == nil && result.Status == model.ResultStatusFailed {
+				return true
+			}
+		case model.RetryOnCritical:
+			if err == nil && result.Status == model.ResultStatusCritical {
+				return true
+			}
+		case model.RetryOnWarning:
+			if err == nil && result.Status == model.ResultStatusWarning {
+				return true
+			}
+		}
+	}
+	return false
+}
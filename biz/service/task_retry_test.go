@@ -0,0 +1,56 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+)
+
+func TestShouldRetry(t *testing.T) {
+	errExec := errors.New("connection refused")
+	tests := []struct {
+		name    string
+		retryOn []model.RetryCondition
+		result  model.TaskResult
+		err     error
+		want    bool
+	}{
+		{name: "default error", err: errExec, want: true},
+		{name: "default failed", result: model.TaskResult{Status: model.ResultStatusFailed}, want: true},
+		{name: "default critical", result: model.TaskResult{Status: model.ResultStatusCritical}, want: false},
+		{name: "default warning", result: model.TaskResult{Status: model.ResultStatusWarning}, want: false},
+		{name: "default normal", result: model.TaskResult{Status: model.ResultStatusNormal}, want: false},
+		{name: "critical opt-in", retryOn: []model.RetryCondition{model.RetryOnCritical}, result: model.TaskResult{Status: model.ResultStatusCritical}, want: true},
+		{name: "critical opt-in ignores failed", retryOn: []model.RetryCondition{model.RetryOnCritical}, result: model.TaskResult{Status: model.ResultStatusFailed}, want: false},
+		{name: "warning opt-in", retryOn: []model.RetryCondition{model.RetryOnWarning}, result: model.TaskResult{Status: model.ResultStatusWarning}, want: true},
+		{name: "final critical", retryOn: []model.RetryCondition{model.RetryOnCritical}, result: model.TaskResult{Status: model.ResultStatusCritical, Final: true}, want: false},
+		{name: "final error", result: model.TaskResult{Final: true}, err: errExec, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := model.TaskItem{ID: 1, Retries: 3, RetryOn: tt.retryOn}
+			if got := shouldRetry(item, tt.result, tt.err); got != tt.want {
+				t.Fatalf("shouldRetry = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryCritical(t *testing.T) {
+	id := testTaskID(t)
+	byDefault := fakeItem(id, 1, "default", map[string]interface{}{"status": "critical"})
+	byDefault.Retries = 2
+	optIn := fakeItem(id, 2, "opt-in", map[string]interface{}{"status": "critical"})
+	optIn.Retries = 2
+	optIn.RetryOn = []model.RetryCondition{model.RetryOnCritical}
+	failed := fakeItem(id, 3, "failed", map[string]interface{}{"error": "dial tcp: connection refused"})
+	failed.Retries = 2
+
+	runTestTask(t, &model.Task{ID: id, Timeout: 5, Items: []model.TaskItem{byDefault, optIn, failed}})
+	for name, want := range map[string]int{"default": 1, "opt-in": 3, "failed": 3} {
+		if got := fakeCalls.count(id, name); got != want {
+			t.Errorf("%s executed %d times, want %d", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"time"
+
+	"github.mokaz111.com/candy-agent/biz/model"
+	candyAgent "github.mokaz111.com/candy-agent/hertz_gen/candyAgent"
+)
+
+// convertTemplateRequest 将巡检模板请求转换为内部模型
+func convertTemplateRequest(req *candyAgent.TemplateRequest) *model.Template {
+	template := &model.Template{
+		Name:        req.Name,
+		Description: req.Description,
+		Items:       convertTaskItems(req.Items),
+		Timeout:     int(req.Timeout),
+		Parallelism: int(req.Parallelism),
+	}
+	for _, variable := range req.Variables {
+		template.Variables = append(template.Variables, model.TemplateVariable{
+			Name:        variable.Name,
+			Description: variable.Description,
+			Default:     variable.Default,
+			Required:    variable.Required,
+		})
+	}
+	return template
+}
+
+// convertTemplate 转换巡检模板
+func convertTemplate(template *model.Template) *candyAgent.Template {
+	converted := &candyAgent.Template{
+		Name:        template.Name,
+		Version:     int32(template.Version),
+		Description: template.Description,
+		Items:       convertModelTaskItems(template.Items),
+		Timeout:     int32(template.Timeout),
+		Parallelism: int32(template.Parallelism),
+		Source:      string(template.Source),
+		CreatedAt:   template.CreatedAt.Format(time.RFC3339),
+	}
+	for _, variable := range template.Variables {
+		converted.Variables = append(converted.Variables, &candyAgent.TemplateVariable{
+			Name:        variable.Name,
+			Description: variable.Description,
+			Default:     variable.Default,
+			Required:    variable.Required,
+		})
+	}
+	for _, version := range GetTemplateRegistry().Versions(template.Name) {
+		converted.Versions = append(converted.Versions, int32(version))
+	}
+	return converted
+}
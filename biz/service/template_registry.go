@@ -0,0 +1,390 @@
+package service
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.mokaz111.com/candy-agent/biz/model"
+	"github.mokaz111.com/candy-agent/conf"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	// templateNamePattern 模板名称只允许字母、数字、下划线、点和中划线
+	templateNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+	// templateVariablePattern 模板变量名称
+	templateVariablePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	// templatePlaceholderPattern 模板变量占位符 ${name}
+	templatePlaceholderPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+)
+
+// TemplateRegistry 巡检模板注册表，管理配置目录加载和接口创建的模板及其所有版本
+type TemplateRegistry struct {
+	store     model.TemplateStore
+	templates map[string][]*model.Template // 模板名称 -> 按版本升序排列的模板
+	mutex     sync.RWMutex
+}
+
+var (
+	templateRegistryInstance *TemplateRegistry
+	templateRegistryOnce     sync.Once
+)
+
+// GetTemplateRegistry 获取巡检模板注册表单例，首次调用时加载配置目录和存储中的模板
+func GetTemplateRegistry() *TemplateRegistry {
+	templateRegistryOnce.Do(func() {
+		templateRegistryInstance = &TemplateRegistry{
+			store:     newTemplateStore(),
+			templates: make(map[string][]*model.Template),
+		}
+		templateRegistryInstance.load()
+	})
+	return templateRegistryInstance
+}
+
+// newTemplateStore 根据配置创建模板存储，创建失败时退化为内存存储
+func newTemplateStore() model.TemplateStore {
+	cfg := conf.GetConf().Template
+	return newStore(storeConfig{name: "模板", kind: cfg.Store, dir: cfg.StoreDir, defaultDir: "data/templates"},
+		func(template *model.Template) string { return model.TemplateKey(template.Name, template.Version) })
+}
+
+// load 加载配置目录中的模板和存储中通过接口创建的模板，配置目录中的模板优先
+func (r *TemplateRegistry) load() {
+	dir := conf.GetConf().Template.Dir
+	if dir == "" {
+		dir = "conf/templates"
+	}
+	fileTemplates := loadTemplateFiles(dir)
+	for _, template := range fileTemplates {
+		r.add(template)
+	}
+
+	stored, err := r.store.LoadAll()
+	if err != nil {
+		hlog.Errorf("加载已保存的模板失败: %v", err)
+	}
+	for _, template := range stored {
+		if versions, exists := r.templates[template.Name]; exists && versions[0].Source == model.TemplateSourceFile {
+			hlog.Warnf("模板 %s 已由配置目录定义，忽略已保存的版本 %d", template.Name, template.Version)
+			continue
+		}
+		template.Source = model.TemplateSourceAPI
+		r.add(template)
+	}
+
+	if len(r.templates) > 0 {
+		hlog.Infof("已加载 %d 个巡检模板，其中 %d 个来自配置目录", len(r.templates), len(fileTemplates))
+	}
+}
+
+// loadTemplateFiles 加载目录中的 YAML/JSON 模板文件，每个文件一个模板版本，无效的文件会被跳过
+func loadTemplateFiles(dir string) []*model.Template {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			hlog.Errorf("读取模板目录 %s 失败: %v", dir, err)
+		}
+		return nil
+	}
+
+	templates := make([]*model.Template, 0, len(entries))
+	for _, entry := range entries {
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		if entry.IsDir() || (ext != ".yaml" && ext != ".yml" && ext != ".json") {
+			continue
+		}
+
+		file := filepath.Join(dir, entry.Name())
+		data, err := os.ReadFile(file)
+		if err != nil {
+			hlog.Errorf("读取模板文件 %s 失败: %v", file, err)
+			continue
+		}
+
+		template := &model.Template{}
+		if err := yaml.Unmarshal(data, template); err != nil {
+			hlog.Errorf("解析模板文件 %s 失败: %v", file, err)
+			continue
+		}
+		if template.Version <= 0 {
+			template.Version = 1
+		}
+		normalizeTemplateParams(template.Items)
+		if err := validateTemplate(template); err != nil {
+			hlog.Errorf("模板文件 %s 无效: %v", file, err)
+			continue
+		}
+		template.Source = model.TemplateSourceFile
+		if info, err := entry.Info(); err == nil {
+			template.CreatedAt = info.ModTime()
+		}
+		templates = append(templates, template)
+	}
+	return templates
+}
+
+// normalizeTemplateParams 将 YAML 中的数字、布尔等参数值转换为字符串，与接口提交的任务项参数保持一致
+func normalizeTemplateParams(items []model.TaskItem) {
+	for _, item := range items {
+		for key, value := range item.Params {
+			if _, ok := value.(string); !ok && value != nil {
+				item.Params[key] = fmt.Sprint(value)
+			}
+		}
+	}
+}
+
+// add 加入模板版本，保持版本升序，同版本覆盖，调用方需持有锁或在初始化阶段调用
+func (r *TemplateRegistry) add(template *model.Template) {
+	versions := r.templates[template.Name]
+	for i, existing := range versions {
+		if existing.Version == template.Version {
+			versions[i] = template
+			return
+		}
+	}
+	versions = append(versions, template)
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Version < versions[j].Version
+	})
+	r.templates[template.Name] = versions
+}
+
+// Create 通过接口创建模板，版本号为1
+func (r *TemplateRegistry) Create(template *model.Template) (*model.Template, error) {
+	if err := validateTemplate(template); err != nil {
+		return nil, err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.templates[template.Name]; exists {
+		return nil, fmt.Errorf("模板已存在: %s", template.Name)
+	}
+	return r.saveVersion(template, 1), nil
+}
+
+// Update 为已有模板创建新版本，旧版本保留，配置目录中的模板不能通过接口修改
+func (r *TemplateRegistry) Update(template *model.Template) (*model.Template, error) {
+	if err := validateTemplate(template); err != nil {
+		return nil, err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	versions, exists := r.templates[template.Name]
+	if !exists {
+		return nil, fmt.Errorf("模板不存在: %s", template.Name)
+	}
+	latest := versions[len(versions)-1]
+	if latest.Source == model.TemplateSourceFile {
+		return nil, fmt.Errorf("模板 %s 由配置目录定义，不能通过接口修改", template.Name)
+	}
+	return r.saveVersion(template, latest.Version+1), nil
+}
+
+// saveVersion 保存模板的新版本并返回副本，调用方需持有锁
+func (r *TemplateRegistry) saveVersion(template *model.Template, version int) *model.Template {
+	template.Version = version
+	template.Source = model.TemplateSourceAPI
+	template.CreatedAt = time.Now()
+	r.add(template)
+	if err := r.store.Save(template); err != nil {
+		hlog.Errorf("保存模板失败: %s@v%d, %v", template.Name, template.Version, err)
+	}
+
+	hlog.Infof("已保存巡检模板: %s@v%d", template.Name, template.Version)
+	snapshot := *template
+	return &snapshot
+}
+
+// Delete 删除模板的指定版本，version<=0 时删除所有版本，配置目录中的模板不能通过接口删除
+func (r *TemplateRegistry) Delete(name string, version int) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	versions, exists := r.templates[name]
+	if !exists {
+		return fmt.Errorf("模板不存在: %s", name)
+	}
+	if versions[0].Source == model.TemplateSourceFile {
+		return fmt.Errorf("模板 %s 由配置目录定义，不能通过接口删除", name)
+	}
+
+	remaining := make([]*model.Template, 0, len(versions))
+	deleted := 0
+	for _, template := range versions {
+		if version > 0 && template.Version != version {
+			remaining = append(remaining, template)
+			continue
+		}
+		if err := r.store.Delete(model.TemplateKey(name, template.Version)); err != nil {
+			hlog.Errorf("删除模板失败: %s@v%d, %v", name, template.Version, err)
+		}
+		deleted++
+	}
+	if deleted == 0 {
+		return fmt.Errorf("模板版本不存在: %s@v%d", name, version)
+	}
+
+	if len(remaining) == 0 {
+		delete(r.templates, name)
+	} else {
+		r.templates[name] = remaining
+	}
+	hlog.Infof("已删除巡检模板: %s，删除 %d 个版本", name, deleted)
+	return nil
+}
+
+// Get 获取模板的指定版本副本，version<=0 时获取最新版本
+func (r *TemplateRegistry) Get(name string, version int) (*model.Template, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	versions, exists := r.templates[name]
+	if !exists {
+		return nil, fmt.Errorf("模板不存在: %s", name)
+	}
+	if version <= 0 {
+		snapshot := *versions[len(versions)-1]
+		return &snapshot, nil
+	}
+	for _, template := range versions {
+		if template.Version == version {
+			snapshot := *template
+			return &snapshot, nil
+		}
+	}
+	return nil, fmt.Errorf("模板版本不存在: %s@v%d", name, version)
+}
+
+// Versions 获取模板的所有版本号
+func (r *TemplateRegistry) Versions(name string) []int {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	versions := make([]int, 0, len(r.templates[name]))
+	for _, template := range r.templates[name] {
+		versions = append(versions, template.Version)
+	}
+	return versions
+}
+
+// List 获取所有模板的最新版本副本，按名称排序
+func (r *TemplateRegistry) List() []model.Template {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	templates := make([]model.Template, 0, len(r.templates))
+	for _, versions := range r.templates {
+		templates = append(templates, *versions[len(versions)-1])
+	}
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].Name < templates[j].Name
+	})
+	return templates
+}
+
+// Render 使用变量渲染模板的任务项，version<=0 时使用最新版本。
+// 未提供的变量使用默认值，缺少必填变量或提供了未声明的变量时返回错误
+func (r *TemplateRegistry) Render(name string, version int, variables map[string]string) (*model.Template, []model.TaskItem, error) {
+	template, err := r.Get(name, version)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := make(map[string]string, len(template.Variables))
+	declared := make(map[string]bool, len(template.Variables))
+	for _, variable := range template.Variables {
+		declared[variable.Name] = true
+		if value, exists := variables[variable.Name]; exists {
+			values[variable.Name] = value
+			continue
+		}
+		if variable.Required {
+			return nil, nil, fmt.Errorf("模板 %s@v%d 缺少必填变量: %s", template.Name, template.Version, variable.Name)
+		}
+		values[variable.Name] = variable.Default
+	}
+	for name := range variables {
+		if !declared[name] {
+			return nil, nil, fmt.Errorf("模板 %s@v%d 未声明变量: %s", template.Name, template.Version, name)
+		}
+	}
+
+	items := copyTaskItems(template.Items)
+	for i := range items {
+		items[i].Name = renderTemplateString(items[i].Name, values)
+		items[i].HistoryKey = renderTemplateString(items[i].HistoryKey, values)
+		for key, value := range items[i].Params {
+			if s, ok := value.(string); ok {
+				items[i].Params[key] = renderTemplateString(s, values)
+			}
+		}
+	}
+	return template, items, nil
+}
+
+// renderTemplateString 替换字符串中的 ${name} 占位符
+func renderTemplateString(s string, values map[string]string) string {
+	return templatePlaceholderPattern.ReplaceAllStringFunc(s, func(placeholder string) string {
+		return values[placeholder[2:len(placeholder)-1]]
+	})
+}
+
+// validateTemplate 校验模板名称、变量声明和任务项，任务项中引用的变量必须已声明
+func validateTemplate(template *model.Template) error {
+	if !templateNamePattern.MatchString(template.Name) {
+		return fmt.Errorf("模板名称无效: %q，只允许字母、数字、下划线、点和中划线", template.Name)
+	}
+	if len(template.Items) == 0 {
+		return fmt.Errorf("模板至少需要一个任务项")
+	}
+	if _, err := buildTaskGraph(template.Items); err != nil {
+		return err
+	}
+	for _, item := range template.Items {
+		if err := validateRetryPolicy(item); err != nil {
+			return err
+		}
+		if err := validateItemWeight(item); err != nil {
+			return err
+		}
+	}
+
+	declared := make(map[string]bool, len(template.Variables))
+	for _, variable := range template.Variables {
+		if !templateVariablePattern.MatchString(variable.Name) {
+			return fmt.Errorf("模板变量名称无效: %q", variable.Name)
+		}
+		if declared[variable.Name] {
+			return fmt.Errorf("模板变量重复: %s", variable.Name)
+		}
+		declared[variable.Name] = true
+	}
+
+	for _, item := range template.Items {
+		placeholders := templatePlaceholderPattern.FindAllStringSubmatch(item.Name+"\n"+item.HistoryKey, -1)
+		for _, value := range item.Params {
+			if s, ok := value.(string); ok {
+				placeholders = append(placeholders, templatePlaceholderPattern.FindAllStringSubmatch(s, -1)...)
+			}
+		}
+		for _, match := range placeholders {
+			if !declared[match[1]] {
+				return fmt.Errorf("任务项 %d 引用了未声明的模板变量: %s", item.ID, match[1])
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	candyAgent "github.mokaz111.com/candy-agent/hertz_gen/candyAgent"
+)
+
+type UpdateScheduleService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewUpdateScheduleService(Context context.Context, RequestContext *app.RequestContext) *UpdateScheduleService {
+	return &UpdateScheduleService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *UpdateScheduleService) Run(req *candyAgent.ScheduleRequest) (resp *candyAgent.ScheduleResponse, err error) {
+	if req.ScheduleId == "" {
+		return nil, fmt.Errorf("定时巡检ID不能为空")
+	}
+
+	schedule, err := GetScheduler().Update(convertScheduleRequest(req))
+	if err != nil {
+		return nil, err
+	}
+
+	hlog.CtxInfof(h.Context, "Updated schedule %s", schedule.ID)
+	return &candyAgent.ScheduleResponse{
+		Schedule: convertSchedule(schedule),
+		Message:  "定时巡检更新成功",
+	}, nil
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	candyAgent "github.mokaz111.com/candy-agent/hertz_gen/candyAgent"
+)
+
+type UpdateTemplateService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewUpdateTemplateService(Context context.Context, RequestContext *app.RequestContext) *UpdateTemplateService {
+	return &UpdateTemplateService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *UpdateTemplateService) Run(req *candyAgent.TemplateRequest) (resp *candyAgent.TemplateResponse, err error) {
+	if req.Name == "" {
+		return nil, fmt.Errorf("模板名称不能为空")
+	}
+
+	template, err := GetTemplateRegistry().Update(convertTemplateRequest(req))
+	if err != nil {
+		return nil, err
+	}
+
+	hlog.CtxInfof(h.Context, "Updated template %s to v%d", template.Name, template.Version)
+	return &candyAgent.TemplateResponse{
+		Template: convertTemplate(template),
+		Message:  fmt.Sprintf("巡检模板已更新为版本 %d", template.Version),
+	}, nil
+}
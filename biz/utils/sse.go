@@ -0,0 +1,57 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/network"
+	"github.com/cloudwego/hertz/pkg/protocol/http1/resp"
+)
+
+// SSEWriter Server-Sent Events 写入器，基于 Hertz 分块响应逐条推送事件
+type SSEWriter struct {
+	writer network.ExtWriter
+	nextID int
+}
+
+// NewSSEWriter 设置事件流响应头并接管响应写入，之后不能再使用 c.JSON 等方法写响应
+func NewSSEWriter(c *app.RequestContext) *SSEWriter {
+	c.SetStatusCode(http.StatusOK)
+	c.Response.Header.Set("Content-Type", "text/event-stream; charset=utf-8")
+	c.Response.Header.Set("Cache-Control", "no-cache")
+	c.Response.Header.Set("Connection", "keep-alive")
+	c.Response.Header.Set("X-Accel-Buffering", "no") // 禁止 Nginx 缓冲事件
+
+	writer := resp.NewChunkedBodyWriter(&c.Response, c.GetWriter())
+	c.Response.HijackWriter(writer)
+	return &SSEWriter{writer: writer}
+}
+
+// Send 发送事件，data 序列化为单行JSON
+func (w *SSEWriter) Send(event string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event data: %v", err)
+	}
+
+	w.nextID++
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "id: %d\nevent: %s\ndata: %s\n\n", w.nextID, event, payload)
+	return w.write(buf.Bytes())
+}
+
+// Comment 发送注释行，用于保持连接
+func (w *SSEWriter) Comment(text string) error {
+	return w.write([]byte(": " + text + "\n\n"))
+}
+
+// write 写入并立即刷新，连接断开时返回错误
+func (w *SSEWriter) write(p []byte) error {
+	if _, err := w.writer.Write(p); err != nil {
+		return err
+	}
+	return w.writer.Flush()
+}
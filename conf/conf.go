@@ -27,6 +27,12 @@ type Config struct {
 	Kubernetes  KubernetesConfig  `yaml:"kubernetes"`
 	Server      ServerConfig      `yaml:"server"`
 	TaskManager TaskManagerConfig `yaml:"task_manager"`
+	Callback    CallbackConfig    `yaml:"callback"`
+	Scheduler   SchedulerConfig   `yaml:"scheduler"`
+	Template    TemplateConfig    `yaml:"template"`
+	History     HistoryConfig     `yaml:"history"`
+
+	ExecutorLimits map[string]ExecutorLimitConfig `yaml:"executor_limits"` // 按执行器类型的限流配置，default 对未单独配置的执行器生效
 }
 
 type CandyServerConfig struct {
@@ -47,6 +53,10 @@ type ExecutorsConfig struct {
 	VM         VMConfig         `yaml:"vm"`
 	SSH        SSHConfig        `yaml:"ssh"`
 	Kubernetes KubernetesConfig `yaml:"kubernetes"`
+	HTTP       HTTPConfig       `yaml:"http"`
+	TCP        TCPConfig        `yaml:"tcp"`
+	DNS        DNSConfig        `yaml:"dns"`
+	Shell      ShellConfig      `yaml:"shell"`
 }
 
 // PrometheusConfig Prometheus 执行器配置
@@ -64,8 +74,37 @@ type VMConfig struct {
 
 // SSHConfig SSH 执行器配置
 type SSHConfig struct {
-	Timeout           int `yaml:"timeout"`
-	ConnectionTimeout int `yaml:"connection_timeout"`
+	Timeout           int    `yaml:"timeout"`
+	ConnectionTimeout int    `yaml:"connection_timeout"`
+	HostKeyPolicy     string `yaml:"host_key_policy"`  // 主机密钥校验策略: known_hosts, tofu, insecure，默认 known_hosts
+	KnownHostsFile    string `yaml:"known_hosts_file"` // known_hosts 文件，tofu 策略也将首次连接的主机密钥记录在该文件
+}
+
+// HTTPConfig HTTP 执行器配置
+type HTTPConfig struct {
+	Timeout int `yaml:"timeout"` // 请求超时(秒)
+}
+
+// TCPConfig TCP 执行器配置
+type TCPConfig struct {
+	Timeout int `yaml:"timeout"` // 连接和读取超时(秒)
+}
+
+// DNSConfig DNS 执行器配置
+type DNSConfig struct {
+	Timeout  int    `yaml:"timeout"`  // 解析超时(秒)
+	Resolver string `yaml:"resolver"` // 默认使用的 DNS 服务器 host:port，留空使用系统配置
+}
+
+// ShellConfig shell 执行器配置
+type ShellConfig struct {
+	Enabled        bool     `yaml:"enabled"`          // 是否注册 shell 执行器
+	Shell          string   `yaml:"shell"`            // 执行命令的 shell，默认 /bin/sh
+	WorkDir        string   `yaml:"work_dir"`         // 工作目录根路径，任务项的 workdir 只能在其中，不限制命令访问的文件
+	EnvAllowlist   []string `yaml:"env_allowlist"`    // 允许传给命令的环境变量
+	Timeout        int      `yaml:"timeout"`          // 执行超时(秒)
+	CPUTime        int      `yaml:"cpu_time"`         // CPU 时间限制(秒)，0 表示不限制
+	MaxOutputBytes int      `yaml:"max_output_bytes"` // 标准输出和标准错误各自保留的最大字节数
 }
 
 // KubernetesConfig Kubernetes 执行器配置
@@ -85,8 +124,57 @@ type ServerConfig struct {
 
 // TaskManagerConfig 任务管理器配置
 type TaskManagerConfig struct {
-	MaxWorkers     int   `yaml:"max_workers"`     // 最大工作线程数
-	TaskExpiration int64 `yaml:"task_expiration"` // 任务过期时间(小时)
+	MaxWorkers      int    `yaml:"max_workers"`      // 最大工作线程数
+	MaxQueueDepth   int    `yaml:"max_queue_depth"`  // 最大排队任务数，队列已满时拒绝新任务
+	TaskExpiration  int64  `yaml:"task_expiration"`  // 任务过期时间(小时)
+	ItemParallelism int    `yaml:"item_parallelism"` // 单个任务内任务项的默认并发数
+	TaskStore       string `yaml:"task_store"`       // 任务存储类型: memory(默认), file
+	TaskStoreDir    string `yaml:"task_store_dir"`   // 文件存储目录
+	RecoverPolicy   string `yaml:"recover_policy"`   // 重启后未完成任务的处理方式: resume(默认), fail
+	DrainTimeout    int    `yaml:"drain_timeout"`    // 关闭时等待执行中任务完成的时间(秒)
+}
+
+// CallbackConfig 任务回调投递配置
+type CallbackConfig struct {
+	Timeout        int    `yaml:"timeout"`         // 单次投递超时(秒)
+	MaxAttempts    int    `yaml:"max_attempts"`    // 最大投递次数，用完后需手动重新投递
+	InitialBackoff int    `yaml:"initial_backoff"` // 首次重试间隔(秒)，之后按2倍递增
+	MaxBackoff     int    `yaml:"max_backoff"`     // 最大重试间隔(秒)
+	Store          string `yaml:"store"`           // 发件箱存储类型: memory(默认), file
+	StoreDir       string `yaml:"store_dir"`       // 文件存储目录
+}
+
+// SchedulerConfig 定时巡检配置
+type SchedulerConfig struct {
+	Store    string `yaml:"store"`     // 定时巡检存储类型: memory(默认), file
+	StoreDir string `yaml:"store_dir"` // 文件存储目录
+}
+
+// TemplateConfig 巡检模板配置
+type TemplateConfig struct {
+	Dir      string `yaml:"dir"`       // 模板文件目录，目录中的模板只读
+	Store    string `yaml:"store"`     // 接口创建的模板存储类型: memory(默认), file
+	StoreDir string `yaml:"store_dir"` // 文件存储目录
+}
+
+// LimitConfig 并发和速率限制，0 表示不限制
+type LimitConfig struct {
+	MaxInFlight int     `yaml:"max_in_flight"` // 同时执行的最大请求数
+	QPS         float64 `yaml:"qps"`           // 每秒最多发起的请求数
+	Burst       int     `yaml:"burst"`         // 允许的突发请求数，默认为 QPS 向上取整
+}
+
+// ExecutorLimitConfig 执行器限流配置
+type ExecutorLimitConfig struct {
+	LimitConfig `yaml:",inline"`
+	PerTarget   LimitConfig `yaml:"per_target"` // 对同一目标(主机、监控后端)的限制
+}
+
+// HistoryConfig 巡检结果历史配置
+type HistoryConfig struct {
+	Limit    int    `yaml:"limit"`     // 每个巡检项保留的历史结果数，默认10
+	Store    string `yaml:"store"`     // 结果历史存储类型: memory(默认), file
+	StoreDir string `yaml:"store_dir"` // 文件存储目录
 }
 
 // HertzConfig Hertz配置
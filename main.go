@@ -22,6 +22,7 @@ import (
 	"github.com/hertz-contrib/pprof"
 	"github.mokaz111.com/candy-agent/biz/dal"
 	"github.mokaz111.com/candy-agent/biz/router"
+	"github.mokaz111.com/candy-agent/biz/service"
 	"github.mokaz111.com/candy-agent/conf"
 	"go.uber.org/zap/zapcore"
 	"gopkg.in/natefinch/lumberjack.v2"
@@ -31,6 +32,21 @@ func main() {
 	// 初始化数据访问层
 	dal.Init()
 
+	// 恢复重启前保存的任务
+	service.GetTaskManager().RecoverTasks()
+
+	// 继续投递重启前未送达的回调
+	service.GetCallbackOutbox()
+
+	// 加载巡检模板
+	service.GetTemplateRegistry()
+
+	// 加载巡检结果历史
+	service.GetResultHistory()
+
+	// 启动定时巡检
+	service.GetScheduler().Start()
+
 	// 获取配置
 	address := conf.GetConf().Hertz.Address
 
@@ -70,6 +86,18 @@ func main() {
 	// 停止心跳服务
 	//heartbeatService.Stop()
 
+	// 停止定时巡检，不再提交新的任务
+	service.GetScheduler().Stop()
+
+	// 停止接收新任务，等待执行中的任务完成，超时后中断剩余任务
+	drainTimeout := time.Duration(conf.GetConf().TaskManager.DrainTimeout) * time.Second
+	if drainTimeout <= 0 {
+		drainTimeout = 20 * time.Second // 默认20秒，需小于 Pod 的 terminationGracePeriodSeconds
+	}
+	drainCtx, cancelDrain := context.WithTimeout(context.Background(), drainTimeout)
+	service.GetTaskManager().Shutdown(drainCtx)
+	cancelDrain()
+
 	// 创建上下文用于优雅关闭
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -79,6 +107,9 @@ func main() {
 		hlog.Fatalf("Server forced to shutdown: %v", err)
 	}
 
+	// 尽量送达中断任务的回调，未送达的回调在文件存储时重启后继续投递
+	service.GetCallbackOutbox().Flush(ctx)
+
 	hlog.Info("Server exiting")
 }
 